@@ -0,0 +1,197 @@
+package validatebr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	// fiscal document models: NF-e, CT-e, MDF-e and NFC-e.
+	accessKeyModels = map[int]bool{55: true, 57: true, 58: true, 65: true}
+
+	ErrInvalidAccessKey = errors.New("invalid access key")
+)
+
+// AccessKey holds the fields of the 44-character chave de acesso of NF-e,
+// NFC-e, CT-e and MDF-e documents.
+type AccessKey struct {
+	UF           UF     // UF of the emitter
+	Year         int    // year of emission
+	Month        int    // month of emission
+	Emitter      string // CNPJ, possibly alphanumeric, or CPF of the emitter
+	Model        int    // 55 NF-e, 57 CT-e, 58 MDF-e or 65 NFC-e
+	Series       int
+	Number       int
+	EmissionType int    // tpEmis
+	Code         string // cNF, the numeric code chosen by the emitter
+	CheckDigit   int
+}
+
+// accessKeyDigit computes the modulo 11 check digit of the first 43
+// characters, with letters valued as in the alphanumeric CNPJ.
+func accessKeyDigit(key string) (int, error) {
+	s := 0
+	w := 2
+	for i := len(key) - 1; i >= 0; i-- {
+		v, err := getAlphanumericValue(rune(key[i]))
+		if err != nil {
+			return 0, err
+		}
+		s += v * w
+		w++
+		if w > 9 {
+			w = 2
+		}
+	}
+
+	r := s % 11
+	if r < 2 {
+		return 0, nil
+	}
+	return 11 - r, nil
+}
+
+func atoiField(s string, field string) (int, error) {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return 0, fmt.Errorf("%w: invalid %s", ErrInvalidAccessKey, field)
+		}
+	}
+	return strconv.Atoi(s)
+}
+
+// ParseAccessKey validates the check digit and decomposes an access key.
+// The emitter must be a valid CNPJ, alphanumeric CNPJ or CPF.
+func ParseAccessKey(key string) (AccessKey, error) {
+	key = strings.ToUpper(RemoveNonAlphaNum(key))
+	if len(key) != 44 {
+		return AccessKey{}, fmt.Errorf("%w: invalid length", ErrInvalidAccessKey)
+	}
+
+	var (
+		k   AccessKey
+		uf  int
+		err error
+	)
+
+	fields := []struct {
+		dst  *int
+		s    string
+		name string
+	}{
+		{&uf, key[0:2], "uf"},
+		{&k.Year, key[2:4], "year"},
+		{&k.Month, key[4:6], "month"},
+		{&k.Model, key[20:22], "model"},
+		{&k.Series, key[22:25], "series"},
+		{&k.Number, key[25:34], "number"},
+		{&k.EmissionType, key[34:35], "emission type"},
+		{&k.CheckDigit, key[43:44], "check digit"},
+	}
+	for _, f := range fields {
+		*f.dst, err = atoiField(f.s, f.name)
+		if err != nil {
+			return AccessKey{}, err
+		}
+	}
+	k.UF = UF(uf)
+	k.Year += 2000
+
+	k.Code = key[35:43]
+	if RemoveNonDigits(k.Code) != k.Code {
+		return AccessKey{}, fmt.Errorf("%w: invalid code", ErrInvalidAccessKey)
+	}
+
+	d, err := accessKeyDigit(key[:43])
+	if err != nil || d != k.CheckDigit {
+		return AccessKey{}, fmt.Errorf("%w: invalid check digit", ErrInvalidAccessKey)
+	}
+
+	// a CPF emitter is padded with zeros to 14 digits; the field is read
+	// as a CPF only when it is not a valid CNPJ, as CNPJs may also start
+	// with zeros.
+	emitter := key[6:20]
+	k.Emitter = emitter
+	if !CNPJ(emitter) && !CNPJAlphanumeric(emitter) &&
+		strings.HasPrefix(emitter, "000") && CPF(emitter[3:]) {
+		k.Emitter = emitter[3:]
+	}
+
+	if err := k.validate(); err != nil {
+		return AccessKey{}, err
+	}
+
+	return k, nil
+}
+
+func (k AccessKey) validate() error {
+	if !k.UF.Valid() {
+		return fmt.Errorf("%w: invalid uf", ErrInvalidAccessKey)
+	}
+
+	if k.Year < 2000 || k.Year > 2099 || k.Month < 1 || k.Month > 12 {
+		return fmt.Errorf("%w: invalid year or month", ErrInvalidAccessKey)
+	}
+
+	switch len(k.Emitter) {
+	case 11:
+		if !CPF(k.Emitter) {
+			return fmt.Errorf("%w: invalid emitter", ErrInvalidAccessKey)
+		}
+	case 14:
+		if !CNPJ(k.Emitter) && !CNPJAlphanumeric(k.Emitter) {
+			return fmt.Errorf("%w: invalid emitter", ErrInvalidAccessKey)
+		}
+	default:
+		return fmt.Errorf("%w: invalid emitter", ErrInvalidAccessKey)
+	}
+
+	if !accessKeyModels[k.Model] {
+		return fmt.Errorf("%w: invalid model", ErrInvalidAccessKey)
+	}
+
+	if k.Series < 0 || k.Series > 999 {
+		return fmt.Errorf("%w: invalid series", ErrInvalidAccessKey)
+	}
+
+	if k.Number < 1 || k.Number > 999999999 {
+		return fmt.Errorf("%w: invalid number", ErrInvalidAccessKey)
+	}
+
+	if k.EmissionType < 1 || k.EmissionType > 9 {
+		return fmt.Errorf("%w: invalid emission type", ErrInvalidAccessKey)
+	}
+
+	if len(k.Code) != 8 || RemoveNonDigits(k.Code) != k.Code {
+		return fmt.Errorf("%w: invalid code", ErrInvalidAccessKey)
+	}
+
+	return nil
+}
+
+// BuildAccessKey validates the components of an access key and returns the
+// 44-character key with its check digit. CheckDigit is ignored.
+func BuildAccessKey(k AccessKey) (string, error) {
+	k.Emitter = strings.ToUpper(RemoveNonAlphaNum(k.Emitter))
+	if err := k.validate(); err != nil {
+		return "", err
+	}
+
+	emitter := k.Emitter
+	if len(emitter) == 11 {
+		emitter = "000" + emitter
+	}
+
+	key := fmt.Sprintf("%02d%02d%02d%s%02d%03d%09d%d%s",
+		k.UF.Code(), k.Year%100, k.Month, emitter, k.Model,
+		k.Series, k.Number, k.EmissionType, k.Code)
+
+	d, err := accessKeyDigit(key)
+	if err != nil {
+		return "", fmt.Errorf("%w: invalid emitter", ErrInvalidAccessKey)
+	}
+
+	return key + strconv.Itoa(d), nil
+}
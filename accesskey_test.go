@@ -0,0 +1,214 @@
+package validatebr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleParseAccessKey demonstrates how to decompose an NF-e access key.
+func ExampleParseAccessKey() {
+	k, err := validatebr.ParseAccessKey("3524 0112 3456 7800 0195 5500 1000 0001 2311 2345 6781")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(k.UF, k.Year, k.Month, k.Emitter, k.Model, k.Series, k.Number, k.EmissionType, k.Code)
+
+	// Output:
+	// SP 2024 1 12345678000195 55 1 123 1 12345678
+}
+
+func TestParseAccessKey(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected validatebr.AccessKey
+		err      error
+	}{
+		{
+			name:  "NF-e with CNPJ emitter",
+			input: "35240112345678000195550010000001231123456781",
+			expected: validatebr.AccessKey{
+				UF: 35, Year: 2024, Month: 1, Emitter: "12345678000195", Model: 55,
+				Series: 1, Number: 123, EmissionType: 1, Code: "12345678", CheckDigit: 1,
+			},
+		},
+		{
+			name:  "NFC-e with CPF emitter",
+			input: "35241200052998224725650010000000011000000000",
+			expected: validatebr.AccessKey{
+				UF: 35, Year: 2024, Month: 12, Emitter: "52998224725", Model: 65,
+				Series: 1, Number: 1, EmissionType: 1, Code: "00000000", CheckDigit: 0,
+			},
+		},
+		{
+			name:  "NF-e with alphanumeric CNPJ emitter",
+			input: "35250312abc34501de35550010000000011000000018",
+			expected: validatebr.AccessKey{
+				UF: 35, Year: 2025, Month: 3, Emitter: "12ABC34501DE35", Model: 55,
+				Series: 1, Number: 1, EmissionType: 1, Code: "00000001", CheckDigit: 8,
+			},
+		},
+		{
+			name:  "Invalid check digit",
+			input: "35240112345678000195550010000001231123456782",
+			err:   validatebr.ErrInvalidAccessKey,
+		},
+		{
+			name:  "Invalid length",
+			input: "3524011234567800019555001000000123112345678",
+			err:   validatebr.ErrInvalidAccessKey,
+		},
+		{
+			name:  "Empty string",
+			input: "",
+			err:   validatebr.ErrInvalidAccessKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validatebr.ParseAccessKey(tt.input)
+			if result != tt.expected || !errors.Is(err, tt.err) {
+				t.Errorf("ParseAccessKey(%q) = %+v, %v; want %+v, %v", tt.input, result, err, tt.expected, tt.err)
+			}
+		})
+	}
+}
+
+// ExampleBuildAccessKey demonstrates how to build an NF-e access key from its components.
+func ExampleBuildAccessKey() {
+	key, err := validatebr.BuildAccessKey(validatebr.AccessKey{
+		UF:           35,
+		Year:         2024,
+		Month:        1,
+		Emitter:      "12.345.678/0001-95",
+		Model:        55,
+		Series:       1,
+		Number:       123,
+		EmissionType: 1,
+		Code:         "12345678",
+	})
+	fmt.Println(key, err)
+
+	// Output:
+	// 35240112345678000195550010000001231123456781 <nil>
+}
+
+func TestBuildAccessKey(t *testing.T) {
+	valid := validatebr.AccessKey{
+		UF: 35, Year: 2024, Month: 12, Emitter: "529.982.247-25", Model: 65,
+		Series: 1, Number: 1, EmissionType: 1, Code: "00000000",
+	}
+
+	tests := []struct {
+		name     string
+		change   func(k *validatebr.AccessKey)
+		expected string
+		err      error
+	}{
+		{
+			name:     "Valid NFC-e with CPF emitter",
+			change:   func(k *validatebr.AccessKey) {},
+			expected: "35241200052998224725650010000000011000000000",
+		},
+		{
+			name: "Valid NF-e with alphanumeric CNPJ emitter",
+			change: func(k *validatebr.AccessKey) {
+				k.Year, k.Month, k.Emitter, k.Model, k.Code = 2025, 3, "12.ABC.345/01DE-35", 55, "00000001"
+			},
+			expected: "35250312ABC34501DE35550010000000011000000018",
+		},
+		{
+			name:   "Invalid UF",
+			change: func(k *validatebr.AccessKey) { k.UF = 34 },
+			err:    validatebr.ErrInvalidAccessKey,
+		},
+		{
+			name:   "Invalid month",
+			change: func(k *validatebr.AccessKey) { k.Month = 13 },
+			err:    validatebr.ErrInvalidAccessKey,
+		},
+		{
+			name:   "Invalid emitter",
+			change: func(k *validatebr.AccessKey) { k.Emitter = "12.345.678/0001-96" },
+			err:    validatebr.ErrInvalidAccessKey,
+		},
+		{
+			name:   "Invalid model",
+			change: func(k *validatebr.AccessKey) { k.Model = 1 },
+			err:    validatebr.ErrInvalidAccessKey,
+		},
+		{
+			name:   "Series out of range",
+			change: func(k *validatebr.AccessKey) { k.Series = 1000 },
+			err:    validatebr.ErrInvalidAccessKey,
+		},
+		{
+			name:   "Number out of range",
+			change: func(k *validatebr.AccessKey) { k.Number = 0 },
+			err:    validatebr.ErrInvalidAccessKey,
+		},
+		{
+			name:   "Invalid emission type",
+			change: func(k *validatebr.AccessKey) { k.EmissionType = 0 },
+			err:    validatebr.ErrInvalidAccessKey,
+		},
+		{
+			name:   "Invalid code",
+			change: func(k *validatebr.AccessKey) { k.Code = "1234567" },
+			err:    validatebr.ErrInvalidAccessKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := valid
+			tt.change(&k)
+			result, err := validatebr.BuildAccessKey(k)
+			if result != tt.expected || !errors.Is(err, tt.err) {
+				t.Errorf("BuildAccessKey(%+v) = %q, %v; want %q, %v", k, result, err, tt.expected, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			if _, err := validatebr.ParseAccessKey(result); err != nil {
+				t.Errorf("ParseAccessKey(%q) error = %v", result, err)
+			}
+		})
+	}
+}
+
+func TestAccessKeyRoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		emitter string
+	}{
+		{name: "CNPJ starting with zeros", emitter: "00000000000191"},
+		{name: "CNPJ", emitter: "12345678000195"},
+		{name: "Alphanumeric CNPJ", emitter: "12ABC34501DE35"},
+		{name: "CPF", emitter: "52998224725"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k := validatebr.AccessKey{
+				UF: validatebr.DF, Year: 2024, Month: 6, Emitter: tt.emitter, Model: 55,
+				Series: 2, Number: 4567, EmissionType: 1, Code: "87654321",
+			}
+			key, err := validatebr.BuildAccessKey(k)
+			if err != nil {
+				t.Fatalf("BuildAccessKey(%+v) error = %v", k, err)
+			}
+
+			k.CheckDigit = int(key[43] - '0')
+			parsed, err := validatebr.ParseAccessKey(key)
+			if err != nil || parsed != k {
+				t.Errorf("ParseAccessKey(%q) = %+v, %v; want %+v", key, parsed, err, k)
+			}
+		})
+	}
+}
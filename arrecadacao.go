@@ -0,0 +1,131 @@
+package validatebr
+
+import (
+	"errors"
+	"strconv"
+)
+
+type ArrecadacaoSegment int
+
+const (
+	SegmentPrefeituras   ArrecadacaoSegment = 1
+	SegmentSaneamento    ArrecadacaoSegment = 2
+	SegmentEnergia       ArrecadacaoSegment = 3
+	SegmentTelecom       ArrecadacaoSegment = 4
+	SegmentGovernamental ArrecadacaoSegment = 5
+	SegmentCNPJ          ArrecadacaoSegment = 6
+	SegmentMultas        ArrecadacaoSegment = 7
+	SegmentBanco         ArrecadacaoSegment = 9
+)
+
+var ErrInvalidArrecadacao = errors.New("invalid arrecadação barcode")
+
+func (s ArrecadacaoSegment) String() string {
+	switch s {
+	case SegmentPrefeituras:
+		return "PREFEITURAS"
+	case SegmentSaneamento:
+		return "SANEAMENTO"
+	case SegmentEnergia:
+		return "ENERGIA ELETRICA E GAS"
+	case SegmentTelecom:
+		return "TELECOMUNICACOES"
+	case SegmentGovernamental:
+		return "ORGAOS GOVERNAMENTAIS"
+	case SegmentCNPJ:
+		return "CARNES E ASSEMELHADOS"
+	case SegmentMultas:
+		return "MULTAS DE TRANSITO"
+	case SegmentBanco:
+		return "USO EXCLUSIVO DO BANCO"
+	}
+	return "INVALID"
+}
+
+// Arrecadacao holds the fields of a utility or tax barcode (código de
+// arrecadação), which always starts with 8.
+type Arrecadacao struct {
+	Barcode   string // 44 digits
+	Segment   ArrecadacaoSegment
+	ValueType int    // 6 or 8 for the amount in reais, 7 or 9 for a reference value
+	Amount    int64  // in cents when ValueType is 6 or 8
+	Company   string // FEBRABAN company code, or the CNPJ base for SegmentCNPJ
+	FreeField string
+}
+
+// arrecadacaoDigit computes a check digit with the module given by the
+// value type: modulo 10 for 6 and 7, modulo 11 for 8 and 9.
+func arrecadacaoDigit(s string, valueType int) int {
+	if valueType == 6 || valueType == 7 {
+		return mod10Digit(s)
+	}
+	r := mod11Sum(s) % 11
+	if r < 2 {
+		return 0
+	}
+	return 11 - r
+}
+
+// ParseArrecadacao validates an arrecadação barcode given as its 44-digit
+// barcode or its 48-digit linha digitável, and decomposes it.
+func ParseArrecadacao(code string) (Arrecadacao, error) {
+	code = RemoveNonDigits(code)
+	if len(code) < 4 || code[0] != '8' {
+		return Arrecadacao{}, ErrInvalidArrecadacao
+	}
+
+	valueType := int(code[2] - '0')
+	if valueType < 6 || valueType > 9 {
+		return Arrecadacao{}, ErrInvalidArrecadacao
+	}
+
+	if len(code) == 48 {
+		barcode := ""
+		for i := 0; i < 48; i += 12 {
+			block := code[i : i+11]
+			if int(code[i+11]-'0') != arrecadacaoDigit(block, valueType) {
+				return Arrecadacao{}, ErrInvalidArrecadacao
+			}
+			barcode += block
+		}
+		code = barcode
+	}
+
+	if len(code) != 44 {
+		return Arrecadacao{}, ErrInvalidArrecadacao
+	}
+
+	if int(code[3]-'0') != arrecadacaoDigit(code[:3]+code[4:], valueType) {
+		return Arrecadacao{}, ErrInvalidArrecadacao
+	}
+
+	a := Arrecadacao{
+		Barcode:   code,
+		Segment:   ArrecadacaoSegment(code[1] - '0'),
+		ValueType: valueType,
+	}
+	if a.Segment == 0 || a.Segment == 8 {
+		return Arrecadacao{}, ErrInvalidArrecadacao
+	}
+
+	a.Amount, _ = strconv.ParseInt(code[4:15], 10, 64)
+
+	n := 19
+	if a.Segment == SegmentCNPJ {
+		n = 23
+	}
+	a.Company = code[15:n]
+	a.FreeField = code[n:]
+
+	return a, nil
+}
+
+// Line returns the 48-digit linha digitável of the barcode.
+func (a Arrecadacao) Line() string {
+	line := ""
+	for i := 0; i < 44; i += 11 {
+		block := a.Barcode[i : i+11]
+		line += block + strconv.Itoa(arrecadacaoDigit(block, a.ValueType))
+	}
+	return line
+}
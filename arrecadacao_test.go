@@ -0,0 +1,109 @@
+package validatebr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleParseArrecadacao demonstrates how to decompose a utility bill from its linha digitável.
+func ExampleParseArrecadacao() {
+	a, err := validatebr.ParseArrecadacao("83620000001-3 12340048100-8 50000000000-9 01234567890-3")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(a.Segment, a.Amount, a.Company)
+
+	// Output:
+	// ENERGIA ELETRICA E GAS 11234 0048
+}
+
+func TestParseArrecadacao(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected validatebr.Arrecadacao
+		err      error
+	}{
+		{
+			name:  "Barcode with modulo 10",
+			input: "83620000001123400481005000000000001234567890",
+			expected: validatebr.Arrecadacao{
+				Barcode: "83620000001123400481005000000000001234567890", Segment: validatebr.SegmentEnergia,
+				ValueType: 6, Amount: 11234, Company: "0048", FreeField: "1005000000000001234567890",
+			},
+		},
+		{
+			name:  "Linha digitável with modulo 10",
+			input: "836200000013123400481008500000000009012345678903",
+			expected: validatebr.Arrecadacao{
+				Barcode: "83620000001123400481005000000000001234567890", Segment: validatebr.SegmentEnergia,
+				ValueType: 6, Amount: 11234, Company: "0048", FreeField: "1005000000000001234567890",
+			},
+		},
+		{
+			name:  "Linha digitável with modulo 11 and CNPJ",
+			input: "86870000002-0 00001234567-9 80000000000-1 00000000001-9",
+			expected: validatebr.Arrecadacao{
+				Barcode: "86870000002000012345678000000000000000000001", Segment: validatebr.SegmentCNPJ,
+				ValueType: 8, Amount: 20000, Company: "12345678", FreeField: "000000000000000000001",
+			},
+		},
+		{
+			name:  "Reference value",
+			input: "89730000000000000019999999999999999999999999",
+			expected: validatebr.Arrecadacao{
+				Barcode: "89730000000000000019999999999999999999999999", Segment: validatebr.SegmentBanco,
+				ValueType: 7, Company: "0001", FreeField: "9999999999999999999999999",
+			},
+		},
+		{
+			name:  "Invalid general check digit",
+			input: "83610000001123400481005000000000001234567890",
+			err:   validatebr.ErrInvalidArrecadacao,
+		},
+		{
+			name:  "Invalid block check digit",
+			input: "836200000014123400481008500000000009012345678903",
+			err:   validatebr.ErrInvalidArrecadacao,
+		},
+		{
+			name:  "Invalid value type",
+			input: "83520000001123400481005000000000001234567890",
+			err:   validatebr.ErrInvalidArrecadacao,
+		},
+		{
+			name:  "Boleto bancário",
+			input: "23791999900001000001234560000000000012345670",
+			err:   validatebr.ErrInvalidArrecadacao,
+		},
+		{
+			name:  "Empty string",
+			input: "",
+			err:   validatebr.ErrInvalidArrecadacao,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validatebr.ParseArrecadacao(tt.input)
+			if result != tt.expected || !errors.Is(err, tt.err) {
+				t.Errorf("ParseArrecadacao(%q) = %+v, %v; want %+v, %v", tt.input, result, err, tt.expected, tt.err)
+			}
+		})
+	}
+}
+
+func TestArrecadacaoLine(t *testing.T) {
+	a, err := validatebr.ParseArrecadacao("86870000002000012345678000000000000000000001")
+	if err != nil {
+		t.Fatalf("ParseArrecadacao() error = %v", err)
+	}
+	want := "868700000020000012345679800000000001000000000019"
+	if line := a.Line(); line != want {
+		t.Errorf("Line() = %q; want %q", line, want)
+	}
+}
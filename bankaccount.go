@@ -0,0 +1,168 @@
+package validatebr
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var (
+	bankAccountMu sync.RWMutex
+
+	// bankAccountRules maps COMPE codes to the validation of branch and
+	// account. Both are received uppercased, without separators, with the
+	// check digit as the last character when the bank uses one.
+	bankAccountRules = map[string]func(branch, account string) bool{
+		"001": bankAccountBB,
+		"033": bankAccountSantander,
+		"104": bankAccountCaixa,
+		"237": bankAccountBradesco,
+		"341": bankAccountItau,
+	}
+
+	// operation codes of Caixa accounts.
+	caixaOperations = map[string]bool{
+		"001": true, // conta corrente pessoa física
+		"002": true, // conta simples pessoa física
+		"003": true, // conta corrente pessoa jurídica
+		"006": true, // entidades públicas
+		"007": true, // depósitos instituições financeiras
+		"013": true, // poupança pessoa física
+		"022": true, // poupança pessoa jurídica
+		"023": true, // conta Caixa fácil
+		"028": true, // poupança de crédito imobiliário
+		"043": true, // depósitos lotéricos
+	}
+)
+
+// RegisterBankAccount adds or replaces the branch and account rule for
+// the bank with the given COMPE code.
+func RegisterBankAccount(compe string, rule func(branch, account string) bool) {
+	bankAccountMu.Lock()
+	defer bankAccountMu.Unlock()
+	bankAccountRules[compe] = rule
+}
+
+// BankAccount validates the branch (agência) and account (conta) check
+// digits of the bank with the given COMPE code. Banks without a rule only
+// have the format checked.
+func BankAccount(compe, branch, account string) bool {
+	bankAccountMu.RLock()
+	rule, ok := bankAccountRules[compe]
+	bankAccountMu.RUnlock()
+	if !ok {
+		rule = bankAccountGeneric
+	}
+
+	branch = strings.ToUpper(RemoveNonAlphaNum(branch))
+	account = strings.ToUpper(RemoveNonAlphaNum(account))
+	if branch == "" || account == "" {
+		return false
+	}
+
+	return rule(branch, account)
+}
+
+// splitCheck splits a number padded to n digits from its check digit.
+func splitCheck(s string, n int) (string, byte, bool) {
+	if len(s) < 2 || len(s)-1 > n {
+		return "", 0, false
+	}
+	num := s[:len(s)-1]
+	if RemoveNonDigits(num) != num {
+		return "", 0, false
+	}
+	return strings.Repeat("0", n-len(num)) + num, s[len(s)-1], true
+}
+
+// weightedDigit computes 11 minus the weighted sum modulo 11, using ten
+// for 10 and 0 for 11.
+func weightedDigit(s string, table []int, ten byte) byte {
+	switch d := 11 - sum(s, table)%11; d {
+	case 10:
+		return ten
+	case 11:
+		return '0'
+	default:
+		return byte('0' + d)
+	}
+}
+
+func bankAccountGeneric(branch, account string) bool {
+	if len(branch) > 5 || RemoveNonDigits(branch) != branch {
+		return false
+	}
+	num := strings.TrimSuffix(account, "X")
+	return len(num) > 0 && len(account) <= 20 && RemoveNonDigits(num) == num
+}
+
+// bankBranch validates a 4-digit branch with an optional check digit.
+func bankBranch(branch string, table []int, ten byte) bool {
+	switch len(branch) {
+	case 4:
+		return RemoveNonDigits(branch) == branch
+	case 5:
+		num, d, ok := splitCheck(branch, 4)
+		return ok && weightedDigit(num, table, ten) == d
+	}
+	return false
+}
+
+func bankAccountBB(branch, account string) bool {
+	if !bankBranch(branch, []int{5, 4, 3, 2}, 'X') {
+		return false
+	}
+	num, d, ok := splitCheck(account, 8)
+	return ok && weightedDigit(num, []int{9, 8, 7, 6, 5, 4, 3, 2}, 'X') == d
+}
+
+func bankAccountBradesco(branch, account string) bool {
+	if !bankBranch(branch, []int{5, 4, 3, 2}, 'P') {
+		return false
+	}
+	num, d, ok := splitCheck(account, 7)
+	return ok && weightedDigit(num, []int{2, 7, 6, 5, 4, 3, 2}, 'P') == d
+}
+
+func bankAccountItau(branch, account string) bool {
+	if len(branch) != 4 || RemoveNonDigits(branch) != branch {
+		return false
+	}
+	num, d, ok := splitCheck(account, 5)
+	return ok && strconv.Itoa(mod10Digit(branch + num))[0] == d
+}
+
+// bankAccountCaixa validates accounts made of a 3-digit operation code,
+// an 8-digit number and the check digit.
+func bankAccountCaixa(branch, account string) bool {
+	if len(branch) != 4 || RemoveNonDigits(branch) != branch {
+		return false
+	}
+	if len(account) != 12 || !caixaOperations[account[:3]] {
+		return false
+	}
+	num, d, ok := splitCheck(account, 11)
+	if !ok {
+		return false
+	}
+	s := sum(branch+num, []int{8, 7, 6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) * 10 % 11
+	return byte('0'+s%10) == d
+}
+
+func bankAccountSantander(branch, account string) bool {
+	if len(branch) != 4 || RemoveNonDigits(branch) != branch {
+		return false
+	}
+	num, d, ok := splitCheck(account, 8)
+	if !ok {
+		return false
+	}
+
+	// only the last digit of each product is added.
+	s := 0
+	table := []int{9, 7, 3, 1, 0, 0, 9, 7, 1, 3, 1, 9, 7, 3}
+	for i, c := range branch + "00" + num {
+		s += int(c-'0') * table[i] % 10
+	}
+	return byte('0'+(10-s%10)%10) == d
+}
@@ -0,0 +1,71 @@
+package validatebr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleBankAccount demonstrates how to validate branch and account check digits.
+func ExampleBankAccount() {
+	fmt.Println(validatebr.BankAccount("001", "0001-9", "210169-6"))
+	fmt.Println(validatebr.BankAccount("341", "0057", "12345-8"))
+
+	// Output:
+	// true
+	// false
+}
+
+func TestBankAccount(t *testing.T) {
+	tests := []struct {
+		name     string
+		compe    string
+		branch   string
+		account  string
+		expected bool
+	}{
+		{name: "Banco do Brasil", compe: "001", branch: "0001-9", account: "00210169-6", expected: true},
+		{name: "Banco do Brasil branch without digit", compe: "001", branch: "1234", account: "12345-5", expected: true},
+		{name: "Banco do Brasil account digit X", compe: "001", branch: "0001-9", account: "6-x", expected: true},
+		{name: "Banco do Brasil branch digit X", compe: "001", branch: "0006-X", account: "1-9", expected: true},
+		{name: "Banco do Brasil invalid branch digit", compe: "001", branch: "0001-8", account: "00210169-6", expected: false},
+		{name: "Banco do Brasil invalid account digit", compe: "001", branch: "0001-9", account: "00210169-7", expected: false},
+		{name: "Itaú", compe: "341", branch: "0057", account: "12345-7", expected: true},
+		{name: "Itaú invalid digit", compe: "341", branch: "0057", account: "12345-8", expected: false},
+		{name: "Itaú branch with digit", compe: "341", branch: "0057-1", account: "12345-7", expected: false},
+		{name: "Bradesco", compe: "237", branch: "1234-3", account: "0001234-3", expected: true},
+		{name: "Bradesco digit P", compe: "237", branch: "0001-9", account: "6-P", expected: true},
+		{name: "Bradesco invalid digit", compe: "237", branch: "1234-3", account: "0001234-4", expected: false},
+		{name: "Caixa", compe: "104", branch: "1234", account: "001.00001234-7", expected: true},
+		{name: "Caixa invalid digit", compe: "104", branch: "1234", account: "001.00001234-8", expected: false},
+		{name: "Caixa unknown operation", compe: "104", branch: "1234", account: "099.00001234-7", expected: false},
+		{name: "Santander", compe: "033", branch: "2006", account: "13000313-3", expected: true},
+		{name: "Santander invalid digit", compe: "033", branch: "2006", account: "13000313-4", expected: false},
+		{name: "Bank without rule", compe: "260", branch: "0001", account: "1234567-8", expected: true},
+		{name: "Bank without rule with letters", compe: "260", branch: "0001", account: "12A4567-8", expected: false},
+		{name: "Empty account", compe: "001", branch: "0001-9", account: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.BankAccount(tt.compe, tt.branch, tt.account)
+			if result != tt.expected {
+				t.Errorf("BankAccount(%q, %q, %q) = %v; want %v", tt.compe, tt.branch, tt.account, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestRegisterBankAccount(t *testing.T) {
+	validatebr.RegisterBankAccount("999", func(branch, account string) bool {
+		return branch == "0001" && account == "1"
+	})
+
+	if !validatebr.BankAccount("999", "0001", "1") {
+		t.Error("BankAccount with registered rule = false; want true")
+	}
+	if validatebr.BankAccount("999", "0001", "2") {
+		t.Error("BankAccount with registered rule = true; want false")
+	}
+}
@@ -0,0 +1,46 @@
+package validatebr
+
+import (
+	"slices"
+	"strings"
+)
+
+//go:generate go run ./processBankTable -o banks_table.go
+
+// Bank is a financial institution participating in the STR or in Pix, as
+// listed by the Banco Central do Brasil.
+type Bank struct {
+	COMPE     string // 3-digit code used by TED and boletos, empty when there is none
+	ISPB      string // 8-digit code used by Pix
+	ShortName string
+	Name      string
+	Pix       bool // whether the institution participates in Pix
+}
+
+// BankByCOMPE looks up an institution by its COMPE code.
+func BankByCOMPE(compe string) (Bank, bool) {
+	compe = RemoveNonDigits(compe)
+	if len(compe) != 3 {
+		return Bank{}, false
+	}
+
+	for _, b := range banks {
+		if b.COMPE == compe {
+			return b, true
+		}
+	}
+
+	return Bank{}, false
+}
+
+// BankByISPB looks up an institution by its ISPB code.
+func BankByISPB(ispb string) (Bank, bool) {
+	ispb = RemoveNonDigits(ispb)
+	i, ok := slices.BinarySearchFunc(banks, ispb, func(b Bank, ispb string) int {
+		return strings.Compare(b.ISPB, ispb)
+	})
+	if !ok {
+		return Bank{}, false
+	}
+	return banks[i], true
+}
@@ -0,0 +1,49 @@
+// Code generated by processBankTable; DO NOT EDIT.
+
+package validatebr
+
+// banks is sorted by ISPB.
+var banks = []Bank{
+	{COMPE: "001", ISPB: "00000000", ShortName: "BCO DO BRASIL S.A.", Name: "Banco do Brasil S.A.", Pix: true},
+	{COMPE: "070", ISPB: "00000208", ShortName: "BRB - BCO DE BRASILIA S.A.", Name: "BRB - BANCO DE BRASILIA S.A.", Pix: true},
+	{COMPE: "", ISPB: "00038166", ShortName: "BCB", Name: "Banco Central do Brasil", Pix: false},
+	{COMPE: "136", ISPB: "00315557", ShortName: "CONF NAC COOP CENTRAIS UNICRED", Name: "CONFEDERAÇÃO NACIONAL DAS COOPERATIVAS CENTRAIS UNICRED LTDA. - UNICRED DO BRASIL", Pix: true},
+	{COMPE: "104", ISPB: "00360305", ShortName: "CAIXA ECONOMICA FEDERAL", Name: "CAIXA ECONOMICA FEDERAL", Pix: true},
+	{COMPE: "077", ISPB: "00416968", ShortName: "BANCO INTER", Name: "Banco Inter S.A.", Pix: true},
+	{COMPE: "739", ISPB: "00558456", ShortName: "BCO CETELEM S.A.", Name: "Banco Cetelem S.A.", Pix: false},
+	{COMPE: "748", ISPB: "01181521", ShortName: "BCO COOPERATIVO SICREDI S.A.", Name: "BANCO COOPERATIVO SICREDI S.A.", Pix: true},
+	{COMPE: "756", ISPB: "02038232", ShortName: "BANCO SICOOB S.A.", Name: "BANCO COOPERATIVO SICOOB S.A. - BANCO SICOOB", Pix: true},
+	{COMPE: "102", ISPB: "02332886", ShortName: "XP INVESTIMENTOS CCTVM S/A", Name: "XP INVESTIMENTOS CORRETORA DE CÂMBIO,TÍTULOS E VALORES MOBILIÁRIOS S/A", Pix: false},
+	{COMPE: "003", ISPB: "04902979", ShortName: "BCO DA AMAZONIA S.A.", Name: "BANCO DA AMAZONIA S.A.", Pix: true},
+	{COMPE: "037", ISPB: "04913711", ShortName: "BCO DO EST. DO PA S.A.", Name: "Banco do Estado do Pará S.A.", Pix: true},
+	{COMPE: "004", ISPB: "07237373", ShortName: "BCO DO NORDESTE DO BRASIL S.A.", Name: "Banco do Nordeste do Brasil S.A.", Pix: true},
+	{COMPE: "082", ISPB: "07679404", ShortName: "BCO TOPÁZIO S.A.", Name: "BANCO TOPÁZIO S.A.", Pix: false},
+	{COMPE: "290", ISPB: "08561701", ShortName: "PAGSEGURO INTERNET IP S.A.", Name: "PAGSEGURO INTERNET INSTITUIÇÃO DE PAGAMENTO S.A.", Pix: true},
+	{COMPE: "323", ISPB: "10573521", ShortName: "MERCADO PAGO IP LTDA.", Name: "MERCADO PAGO INSTITUIÇÃO DE PAGAMENTO LTDA.", Pix: true},
+	{COMPE: "047", ISPB: "13009717", ShortName: "BCO DO EST. DE SE S.A.", Name: "Banco do Estado de Sergipe S.A.", Pix: true},
+	{COMPE: "332", ISPB: "13140088", ShortName: "ACESSO SOLUCOES PAGAMENTO SA", Name: "ACESSO SOLUÇÕES DE PAGAMENTO S.A. - INSTITUIÇÃO DE PAGAMENTO", Pix: true},
+	{COMPE: "197", ISPB: "16501555", ShortName: "STONE IP S.A.", Name: "STONE INSTITUIÇÃO DE PAGAMENTO S.A.", Pix: true},
+	{COMPE: "389", ISPB: "17184037", ShortName: "BCO MERCANTIL DO BRASIL S.A.", Name: "Banco Mercantil do Brasil S.A.", Pix: true},
+	{COMPE: "260", ISPB: "18236120", ShortName: "NU PAGAMENTOS - IP", Name: "NU PAGAMENTOS S.A. - INSTITUIÇÃO DE PAGAMENTO", Pix: true},
+	{COMPE: "380", ISPB: "22896431", ShortName: "PICPAY", Name: "PICPAY INSTITUIÇÃO DE PAGAMENTO S.A.", Pix: true},
+	{COMPE: "021", ISPB: "28127603", ShortName: "BCO BANESTES S.A.", Name: "BANESTES S.A. BANCO DO ESTADO DO ESPIRITO SANTO", Pix: true},
+	{COMPE: "208", ISPB: "30306294", ShortName: "BCO BTG PACTUAL S.A.", Name: "Banco BTG Pactual S.A.", Pix: true},
+	{COMPE: "746", ISPB: "30723886", ShortName: "BCO MODAL S.A.", Name: "Banco Modal S.A.", Pix: false},
+	{COMPE: "336", ISPB: "31872495", ShortName: "BCO C6 S.A.", Name: "Banco C6 S.A.", Pix: true},
+	{COMPE: "376", ISPB: "33172537", ShortName: "BCO J.P. MORGAN S.A.", Name: "BANCO J.P. MORGAN S.A.", Pix: false},
+	{COMPE: "348", ISPB: "33264668", ShortName: "BCO XP S.A.", Name: "Banco XP S.A.", Pix: true},
+	{COMPE: "745", ISPB: "33479023", ShortName: "BCO CITIBANK S.A.", Name: "Banco Citibank S.A.", Pix: false},
+	{COMPE: "403", ISPB: "37880206", ShortName: "CORA SCD S.A.", Name: "CORA SOCIEDADE DE CRÉDITO DIRETO S.A.", Pix: true},
+	{COMPE: "422", ISPB: "58160789", ShortName: "BCO SAFRA S.A.", Name: "Banco Safra S.A.", Pix: true},
+	{COMPE: "623", ISPB: "59285411", ShortName: "BANCO PAN", Name: "Banco Pan S.A.", Pix: true},
+	{COMPE: "655", ISPB: "59588111", ShortName: "BCO VOTORANTIM S.A.", Name: "Banco Votorantim S.A.", Pix: true},
+	{COMPE: "341", ISPB: "60701190", ShortName: "ITAÚ UNIBANCO S.A.", Name: "ITAÚ UNIBANCO S.A.", Pix: true},
+	{COMPE: "237", ISPB: "60746948", ShortName: "BCO BRADESCO S.A.", Name: "Banco Bradesco S.A.", Pix: true},
+	{COMPE: "318", ISPB: "61186680", ShortName: "BCO BMG S.A.", Name: "Banco BMG S.A.", Pix: true},
+	{COMPE: "707", ISPB: "62232889", ShortName: "BCO DAYCOVAL S.A", Name: "Banco Daycoval S.A.", Pix: true},
+	{COMPE: "633", ISPB: "68900810", ShortName: "BCO RENDIMENTO S.A.", Name: "Banco Rendimento S.A.", Pix: true},
+	{COMPE: "218", ISPB: "71027866", ShortName: "BCO BS2 S.A.", Name: "Banco BS2 S.A.", Pix: true},
+	{COMPE: "033", ISPB: "90400888", ShortName: "BCO SANTANDER (BRASIL) S.A.", Name: "BANCO SANTANDER (BRASIL) S.A.", Pix: true},
+	{COMPE: "041", ISPB: "92702067", ShortName: "BCO DO ESTADO DO RS S.A.", Name: "Banco do Estado do Rio Grande do Sul S.A.", Pix: true},
+	{COMPE: "212", ISPB: "92894922", ShortName: "BANCO ORIGINAL", Name: "Banco Original S.A.", Pix: true},
+}
@@ -0,0 +1,69 @@
+package validatebr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleBankByISPB demonstrates how to find the COMPE code of a Pix participant.
+func ExampleBankByISPB() {
+	b, ok := validatebr.BankByISPB("18236120")
+	fmt.Println(b.COMPE, b.ShortName, b.Pix, ok)
+
+	// Output:
+	// 260 NU PAGAMENTOS - IP true true
+}
+
+func TestBankByCOMPE(t *testing.T) {
+	tests := []struct {
+		name  string
+		compe string
+		ispb  string
+		found bool
+	}{
+		{name: "Banco do Brasil", compe: "001", ispb: "00000000", found: true},
+		{name: "Itaú", compe: "341", ispb: "60701190", found: true},
+		{name: "Santander", compe: "033", ispb: "90400888", found: true},
+		{name: "Banco Votorantim", compe: "655", ispb: "59588111", found: true},
+		{name: "PicPay", compe: "380", ispb: "22896431", found: true},
+		{name: "Unknown code", compe: "999", found: false},
+		{name: "Invalid code", compe: "1", found: false},
+		{name: "Empty string", compe: "", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, ok := validatebr.BankByCOMPE(tt.compe)
+			if ok != tt.found || b.ISPB != tt.ispb {
+				t.Errorf("BankByCOMPE(%q) = %+v, %v; want ISPB %q, %v", tt.compe, b, ok, tt.ispb, tt.found)
+			}
+		})
+	}
+}
+
+func TestBankByISPB(t *testing.T) {
+	tests := []struct {
+		name  string
+		ispb  string
+		compe string
+		pix   bool
+		found bool
+	}{
+		{name: "Caixa", ispb: "00360305", compe: "104", pix: true, found: true},
+		{name: "Bradesco", ispb: "60746948", compe: "237", pix: true, found: true},
+		{name: "Institution without COMPE code", ispb: "00038166", compe: "", pix: false, found: true},
+		{name: "Unknown ISPB", ispb: "12345678", found: false},
+		{name: "Empty string", ispb: "", found: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, ok := validatebr.BankByISPB(tt.ispb)
+			if ok != tt.found || b.COMPE != tt.compe || b.Pix != tt.pix {
+				t.Errorf("BankByISPB(%q) = %+v, %v; want COMPE %q, Pix %v, %v", tt.ispb, b, ok, tt.compe, tt.pix, tt.found)
+			}
+		})
+	}
+}
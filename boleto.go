@@ -0,0 +1,152 @@
+package validatebr
+
+import (
+	"errors"
+	"strconv"
+)
+
+var ErrInvalidBoleto = errors.New("invalid boleto")
+
+// Boleto holds the fields of a boleto bancário barcode.
+type Boleto struct {
+	Barcode   string // 44 digits
+	Bank      string // COMPE code
+	Currency  int    // 9 for real
+	DueFactor int    // due-date factor, 0 when there is no due date
+	Amount    int64  // in cents
+	FreeField string // campo livre, 25 bank-specific digits
+}
+
+// mod10Digit computes the modulo 10 check digit with weights 2 and 1
+// from the right, adding the digits of each product.
+func mod10Digit(s string) int {
+	t := 0
+	w := 2
+	for i := len(s) - 1; i >= 0; i-- {
+		p := int(s[i]-'0') * w
+		t += p/10 + p%10
+		w = 3 - w
+	}
+	return (10 - t%10) % 10
+}
+
+// mod11Sum sums the digits with weights 2 to 9 from the right.
+func mod11Sum(s string) int {
+	t := 0
+	w := 2
+	for i := len(s) - 1; i >= 0; i-- {
+		t += int(s[i]-'0') * w
+		w++
+		if w > 9 {
+			w = 2
+		}
+	}
+	return t
+}
+
+// boletoDigit computes the general check digit of a barcode from the
+// other 43 digits. Results 0, 10 and 11 become 1.
+func boletoDigit(s string) int {
+	d := 11 - mod11Sum(s)%11
+	if d == 0 || d >= 10 {
+		return 1
+	}
+	return d
+}
+
+// ParseBoleto validates a boleto bancário given as its 44-digit barcode or
+// its 47-digit linha digitável, and decomposes it.
+func ParseBoleto(code string) (Boleto, error) {
+	code = RemoveNonDigits(code)
+	if len(code) == 47 {
+		var err error
+		code, err = BoletoLineToBarcode(code)
+		if err != nil {
+			return Boleto{}, err
+		}
+	}
+
+	if len(code) != 44 || code[0] == '8' {
+		return Boleto{}, ErrInvalidBoleto
+	}
+
+	if int(code[4]-'0') != boletoDigit(code[:4]+code[5:]) {
+		return Boleto{}, ErrInvalidBoleto
+	}
+
+	factor, _ := strconv.Atoi(code[5:9])
+	amount, _ := strconv.ParseInt(code[9:19], 10, 64)
+
+	return Boleto{
+		Barcode:   code,
+		Bank:      code[:3],
+		Currency:  int(code[3] - '0'),
+		DueFactor: factor,
+		Amount:    amount,
+		FreeField: code[19:],
+	}, nil
+}
+
+// Line returns the 47-digit linha digitável of the boleto.
+func (b Boleto) Line() string {
+	bc := b.Barcode
+	f1 := bc[0:4] + bc[19:24]
+	f2 := bc[24:34]
+	f3 := bc[34:44]
+
+	return f1 + strconv.Itoa(mod10Digit(f1)) +
+		f2 + strconv.Itoa(mod10Digit(f2)) +
+		f3 + strconv.Itoa(mod10Digit(f3)) +
+		bc[4:19]
+}
+
+// BoletoBarcodeToLine converts a 44-digit barcode to its linha digitável.
+func BoletoBarcodeToLine(barcode string) (string, error) {
+	barcode = RemoveNonDigits(barcode)
+	if len(barcode) != 44 {
+		return "", ErrInvalidBoleto
+	}
+
+	b, err := ParseBoleto(barcode)
+	if err != nil {
+		return "", err
+	}
+	return b.Line(), nil
+}
+
+// BoletoLineToBarcode converts a 47-digit linha digitável to its barcode,
+// validating the check digit of each field.
+func BoletoLineToBarcode(line string) (string, error) {
+	line = RemoveNonDigits(line)
+	if len(line) != 47 {
+		return "", ErrInvalidBoleto
+	}
+
+	fields := []struct{ s, d string }{
+		{line[0:9], line[9:10]},
+		{line[10:20], line[20:21]},
+		{line[21:31], line[31:32]},
+	}
+	for _, f := range fields {
+		if strconv.Itoa(mod10Digit(f.s)) != f.d {
+			return "", ErrInvalidBoleto
+		}
+	}
+
+	barcode := line[0:4] + line[32:47] + line[4:9] + line[10:20] + line[21:31]
+	if int(barcode[4]-'0') != boletoDigit(barcode[:4]+barcode[5:]) {
+		return "", ErrInvalidBoleto
+	}
+
+	return barcode, nil
+}
+
+// FormatBoletoLine formats a linha digitável as
+// 00000.00000 00000.000000 00000.000000 0 00000000000000.
+func FormatBoletoLine(line string) (string, error) {
+	line = RemoveNonDigits(line)
+	if len(line) != 47 {
+		return "", ErrInvalidLength
+	}
+	return applyMask(line, "#####.##### #####.###### #####.###### # ##############"), nil
+}
@@ -0,0 +1,129 @@
+package validatebr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleParseBoleto demonstrates how to decompose a boleto from its linha digitável.
+func ExampleParseBoleto() {
+	b, err := validatebr.ParseBoleto("00190.00009 01234.567004 00000.001172 1 10000000012345")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(b.Bank, b.Currency, b.DueFactor, b.Amount, b.FreeField)
+	fmt.Println(b.Barcode)
+
+	// Output:
+	// 001 9 1000 12345 0000001234567000000000117
+	// 00191100000000123450000001234567000000000117
+}
+
+func TestParseBoleto(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected validatebr.Boleto
+		err      error
+	}{
+		{
+			name:  "Valid barcode",
+			input: "23791999900001000001234560000000000012345670",
+			expected: validatebr.Boleto{
+				Barcode: "23791999900001000001234560000000000012345670", Bank: "237", Currency: 9,
+				DueFactor: 9999, Amount: 100000, FreeField: "1234560000000000012345670",
+			},
+		},
+		{
+			name:  "Valid linha digitável",
+			input: "23791.23454 60000.000004 00123.456709 1 99990000100000",
+			expected: validatebr.Boleto{
+				Barcode: "23791999900001000001234560000000000012345670", Bank: "237", Currency: 9,
+				DueFactor: 9999, Amount: 100000, FreeField: "1234560000000000012345670",
+			},
+		},
+		{
+			name:  "Barcode without due date or amount",
+			input: "34196000000000000001090000000000000000000000",
+			expected: validatebr.Boleto{
+				Barcode: "34196000000000000001090000000000000000000000", Bank: "341", Currency: 9,
+				FreeField: "1090000000000000000000000",
+			},
+		},
+		{
+			name:  "Invalid barcode check digit",
+			input: "23792999900001000001234560000000000012345670",
+			err:   validatebr.ErrInvalidBoleto,
+		},
+		{
+			name:  "Invalid linha digitável field check digit",
+			input: "23791.23455 60000.000004 00123.456709 1 99990000100000",
+			err:   validatebr.ErrInvalidBoleto,
+		},
+		{
+			name:  "Invalid linha digitável general check digit",
+			input: "23791.23454 60000.000004 00123.456709 2 99990000100000",
+			err:   validatebr.ErrInvalidBoleto,
+		},
+		{
+			name:  "Arrecadação barcode",
+			input: "83640000001123400481005000000000001234567890",
+			err:   validatebr.ErrInvalidBoleto,
+		},
+		{
+			name:  "Invalid length",
+			input: "2379199990000100000123456000000000001234567",
+			err:   validatebr.ErrInvalidBoleto,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validatebr.ParseBoleto(tt.input)
+			if result != tt.expected || !errors.Is(err, tt.err) {
+				t.Errorf("ParseBoleto(%q) = %+v, %v; want %+v, %v", tt.input, result, err, tt.expected, tt.err)
+			}
+		})
+	}
+}
+
+func TestBoletoConversion(t *testing.T) {
+	tests := []struct {
+		barcode string
+		line    string
+	}{
+		{
+			barcode: "00191100000000123450000001234567000000000117",
+			line:    "00190000090123456700400000001172110000000012345",
+		},
+		{
+			barcode: "23791999900001000001234560000000000012345670",
+			line:    "23791234546000000000400123456709199990000100000",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.barcode, func(t *testing.T) {
+			line, err := validatebr.BoletoBarcodeToLine(tt.barcode)
+			if line != tt.line || err != nil {
+				t.Errorf("BoletoBarcodeToLine(%q) = %q, %v; want %q", tt.barcode, line, err, tt.line)
+			}
+			barcode, err := validatebr.BoletoLineToBarcode(tt.line)
+			if barcode != tt.barcode || err != nil {
+				t.Errorf("BoletoLineToBarcode(%q) = %q, %v; want %q", tt.line, barcode, err, tt.barcode)
+			}
+		})
+	}
+}
+
+func TestFormatBoletoLine(t *testing.T) {
+	line, err := validatebr.FormatBoletoLine("23791234546000000000400123456709199990000100000")
+	want := "23791.23454 60000.000004 00123.456709 1 99990000100000"
+	if line != want || err != nil {
+		t.Errorf("FormatBoletoLine() = %q, %v; want %q", line, err, want)
+	}
+}
@@ -0,0 +1,207 @@
+package validatebr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrInvalidFreeField = errors.New("invalid free field")
+
+// FreeFieldEncoder builds the 25-digit campo livre of a bank.
+type FreeFieldEncoder interface {
+	BankCode() string
+	FreeField() (string, error)
+}
+
+// padDigits left-pads a numeric field with zeros to n digits.
+func padDigits(s string, n int) (string, error) {
+	if len(s) > n || RemoveNonDigits(s) != s {
+		return "", ErrInvalidFreeField
+	}
+	return strings.Repeat("0", n-len(s)) + s, nil
+}
+
+// padFields pads each field to its size, in order.
+func padFields(fields []*string, sizes []int) error {
+	for i, f := range fields {
+		v, err := padDigits(*f, sizes[i])
+		if err != nil {
+			return err
+		}
+		*f = v
+	}
+	return nil
+}
+
+// BuildBoleto composes a boleto with currency 9 (real). A zero due date
+// gives factor 0, for boletos without a due date.
+func BuildBoleto(enc FreeFieldEncoder, due time.Time, amount int64) (Boleto, error) {
+	bank := enc.BankCode()
+	if len(bank) != 3 || RemoveNonDigits(bank) != bank {
+		return Boleto{}, ErrInvalidBoleto
+	}
+
+	free, err := enc.FreeField()
+	if err != nil {
+		return Boleto{}, err
+	}
+	if len(free) != 25 || RemoveNonDigits(free) != free {
+		return Boleto{}, ErrInvalidFreeField
+	}
+
+	if amount < 0 || amount > 9999999999 {
+		return Boleto{}, ErrInvalidBoleto
+	}
+
+	factor := 0
+	if !due.IsZero() {
+		factor, err = BoletoDueFactor(due)
+		if err != nil {
+			return Boleto{}, err
+		}
+	}
+
+	s := fmt.Sprintf("%s9%04d%010d%s", bank, factor, amount, free)
+	barcode := s[:4] + strconv.Itoa(boletoDigit(s)) + s[4:]
+
+	return Boleto{
+		Barcode:   barcode,
+		Bank:      bank,
+		Currency:  9,
+		DueFactor: factor,
+		Amount:    amount,
+		FreeField: free,
+	}, nil
+}
+
+// RawFreeField is a campo livre already encoded by the caller.
+type RawFreeField struct {
+	Bank  string
+	Field string
+}
+
+func (r RawFreeField) BankCode() string { return r.Bank }
+
+func (r RawFreeField) FreeField() (string, error) {
+	if len(r.Field) != 25 || RemoveNonDigits(r.Field) != r.Field {
+		return "", ErrInvalidFreeField
+	}
+	return r.Field, nil
+}
+
+// BancoDoBrasilFreeField encodes the campo livre of Banco do Brasil for
+// 7-digit convênios.
+type BancoDoBrasilFreeField struct {
+	Convenio    string // 7 digits
+	NossoNumero string // 10 digits, without the convênio
+	Carteira    string // 2 digits
+}
+
+func (f BancoDoBrasilFreeField) BankCode() string { return "001" }
+
+func (f BancoDoBrasilFreeField) FreeField() (string, error) {
+	err := padFields([]*string{&f.Convenio, &f.NossoNumero, &f.Carteira}, []int{7, 10, 2})
+	if err != nil {
+		return "", err
+	}
+	return "000000" + f.Convenio + f.NossoNumero + f.Carteira, nil
+}
+
+// ItauFreeField encodes the campo livre of Itaú.
+type ItauFreeField struct {
+	Carteira    string // 3 digits
+	NossoNumero string // 8 digits
+	Agencia     string // 4 digits
+	Conta       string // 5 digits, without the DAC
+}
+
+func (f ItauFreeField) BankCode() string { return "341" }
+
+func (f ItauFreeField) FreeField() (string, error) {
+	err := padFields([]*string{&f.Carteira, &f.NossoNumero, &f.Agencia, &f.Conta}, []int{3, 8, 4, 5})
+	if err != nil {
+		return "", err
+	}
+
+	// these carteiras compute the nosso número DAC without agency and account.
+	dac := mod10Digit(f.Agencia + f.Conta + f.Carteira + f.NossoNumero)
+	switch f.Carteira {
+	case "126", "131", "146", "150", "168":
+		dac = mod10Digit(f.Carteira + f.NossoNumero)
+	}
+
+	return f.Carteira + f.NossoNumero + strconv.Itoa(dac) +
+		f.Agencia + f.Conta + strconv.Itoa(mod10Digit(f.Agencia+f.Conta)) + "000", nil
+}
+
+// BradescoFreeField encodes the campo livre of Bradesco.
+type BradescoFreeField struct {
+	Agencia     string // 4 digits, without the check digit
+	Carteira    string // 2 digits
+	NossoNumero string // 11 digits, without the check digit
+	Conta       string // 7 digits, without the check digit
+}
+
+func (f BradescoFreeField) BankCode() string { return "237" }
+
+func (f BradescoFreeField) FreeField() (string, error) {
+	err := padFields([]*string{&f.Agencia, &f.Carteira, &f.NossoNumero, &f.Conta}, []int{4, 2, 11, 7})
+	if err != nil {
+		return "", err
+	}
+	return f.Agencia + f.Carteira + f.NossoNumero + f.Conta + "0", nil
+}
+
+// CaixaFreeField encodes the campo livre of Caixa (SIGCB).
+type CaixaFreeField struct {
+	Beneficiario string // 6 digits
+	NossoNumero  string // 17 digits: modality, issuer and 15-digit sequence
+}
+
+func (f CaixaFreeField) BankCode() string { return "104" }
+
+func caixaDigit(s string) int {
+	d := 11 - mod11Sum(s)%11
+	if d > 9 {
+		return 0
+	}
+	return d
+}
+
+func (f CaixaFreeField) FreeField() (string, error) {
+	err := padFields([]*string{&f.Beneficiario, &f.NossoNumero}, []int{6, 17})
+	if err != nil {
+		return "", err
+	}
+
+	nn := f.NossoNumero
+	s := f.Beneficiario + strconv.Itoa(caixaDigit(f.Beneficiario)) +
+		nn[2:5] + nn[0:1] + nn[5:8] + nn[1:2] + nn[8:17]
+	return s + strconv.Itoa(caixaDigit(s)), nil
+}
+
+// SantanderFreeField encodes the campo livre of Santander.
+type SantanderFreeField struct {
+	Beneficiario string // 7 digits
+	NossoNumero  string // 12 digits, without the check digit
+	Carteira     string // 3 digits
+}
+
+func (f SantanderFreeField) BankCode() string { return "033" }
+
+func (f SantanderFreeField) FreeField() (string, error) {
+	err := padFields([]*string{&f.Beneficiario, &f.NossoNumero, &f.Carteira}, []int{7, 12, 3})
+	if err != nil {
+		return "", err
+	}
+
+	d := 0
+	if r := mod11Sum(f.NossoNumero) % 11; r > 1 {
+		d = 11 - r
+	}
+
+	return "9" + f.Beneficiario + f.NossoNumero + strconv.Itoa(d) + "0" + f.Carteira, nil
+}
@@ -0,0 +1,145 @@
+package validatebr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleBuildBoleto demonstrates how to compose an Itaú boleto.
+func ExampleBuildBoleto() {
+	due := time.Date(2025, time.May, 10, 0, 0, 0, 0, time.UTC)
+	b, err := validatebr.BuildBoleto(validatebr.ItauFreeField{
+		Carteira:    "109",
+		NossoNumero: "12345678",
+		Agencia:     "0057",
+		Conta:       "12345",
+	}, due, 15050)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	line, _ := validatebr.FormatBoletoLine(b.Line())
+	fmt.Println(b.Barcode)
+	fmt.Println(line)
+
+	// Output:
+	// 34191107700000150501091234567800057123457000
+	// 34191.09123 34567.800056 71234.570001 1 10770000015050
+}
+
+func TestBuildBoleto(t *testing.T) {
+	due := time.Date(2025, time.May, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		enc      validatebr.FreeFieldEncoder
+		due      time.Time
+		amount   int64
+		expected string
+		err      error
+	}{
+		{
+			name:     "Banco do Brasil",
+			enc:      validatebr.BancoDoBrasilFreeField{Convenio: "1234567", NossoNumero: "1", Carteira: "17"},
+			due:      due,
+			amount:   15050,
+			expected: "00197107700000150500000001234567000000000117",
+		},
+		{
+			name:     "Itaú",
+			enc:      validatebr.ItauFreeField{Carteira: "109", NossoNumero: "12345678", Agencia: "0057", Conta: "12345"},
+			due:      due,
+			amount:   15050,
+			expected: "34191107700000150501091234567800057123457000",
+		},
+		{
+			name:     "Itaú carteira with DAC over nosso número only",
+			enc:      validatebr.ItauFreeField{Carteira: "126", NossoNumero: "12345678", Agencia: "0057", Conta: "12345"},
+			due:      due,
+			amount:   15050,
+			expected: "34193107700000150501261234567850057123457000",
+		},
+		{
+			name:     "Bradesco",
+			enc:      validatebr.BradescoFreeField{Agencia: "1234", Carteira: "09", NossoNumero: "123", Conta: "7654321"},
+			due:      due,
+			amount:   15050,
+			expected: "23791107700000150501234090000000012376543210",
+		},
+		{
+			name:     "Caixa",
+			enc:      validatebr.CaixaFreeField{Beneficiario: "123456", NossoNumero: "14000000000000123"},
+			due:      due,
+			amount:   15050,
+			expected: "10495107700000150501234560000100040000001230",
+		},
+		{
+			name:     "Santander",
+			enc:      validatebr.SantanderFreeField{Beneficiario: "1234567", NossoNumero: "123", Carteira: "101"},
+			due:      due,
+			amount:   15050,
+			expected: "03391107700000150509123456700000000012360101",
+		},
+		{
+			name:     "Raw free field without due date",
+			enc:      validatebr.RawFreeField{Bank: "748", Field: "1234567890123456789012345"},
+			amount:   0,
+			expected: "74894000000000000001234567890123456789012345",
+		},
+		{
+			name:   "Field too long",
+			enc:    validatebr.BradescoFreeField{Agencia: "12345", Carteira: "09", NossoNumero: "123", Conta: "7654321"},
+			due:    due,
+			amount: 15050,
+			err:    validatebr.ErrInvalidFreeField,
+		},
+		{
+			name:   "Field with letters",
+			enc:    validatebr.BancoDoBrasilFreeField{Convenio: "12345A7", NossoNumero: "1", Carteira: "17"},
+			due:    due,
+			amount: 15050,
+			err:    validatebr.ErrInvalidFreeField,
+		},
+		{
+			name:   "Invalid bank code",
+			enc:    validatebr.RawFreeField{Bank: "74", Field: "1234567890123456789012345"},
+			due:    due,
+			amount: 15050,
+			err:    validatebr.ErrInvalidBoleto,
+		},
+		{
+			name:   "Amount too large",
+			enc:    validatebr.RawFreeField{Bank: "748", Field: "1234567890123456789012345"},
+			due:    due,
+			amount: 10000000000,
+			err:    validatebr.ErrInvalidBoleto,
+		},
+		{
+			name:   "Due date before the first factor",
+			enc:    validatebr.RawFreeField{Bank: "748", Field: "1234567890123456789012345"},
+			due:    time.Date(1999, time.January, 1, 0, 0, 0, 0, time.UTC),
+			amount: 15050,
+			err:    validatebr.ErrInvalidDueDate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := validatebr.BuildBoleto(tt.enc, tt.due, tt.amount)
+			if b.Barcode != tt.expected || !errors.Is(err, tt.err) {
+				t.Fatalf("BuildBoleto() = %q, %v; want %q, %v", b.Barcode, err, tt.expected, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			parsed, err := validatebr.ParseBoleto(b.Line())
+			if err != nil || parsed != b {
+				t.Errorf("ParseBoleto(%q) = %+v, %v; want %+v", b.Line(), parsed, err, b)
+			}
+		})
+	}
+}
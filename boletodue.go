@@ -0,0 +1,75 @@
+package validatebr
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// the factor restarts at 1000 after 9999, every 9000 days.
+	boletoFactorCycle = 9000
+
+	// FEBRABAN resolves a factor to a date between 3000 days before and
+	// 5500 days after the reference date; the 500 days left in the cycle
+	// are not assigned to any date.
+	boletoPastDays   = 3000
+	boletoFutureDays = 5500
+)
+
+var (
+	// boletoFactorBase is the date of factor 1000.
+	boletoFactorBase = time.Date(2000, time.July, 3, 0, 0, 0, 0, time.UTC)
+
+	ErrNoDueDate        = errors.New("boleto has no due date")
+	ErrInvalidDueFactor = errors.New("invalid due-date factor")
+	ErrInvalidDueDate   = errors.New("invalid due date")
+)
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// BoletoDueDate converts a due-date factor to a date. Since the factor
+// rolled over from 9999 to 1000 on 22 Feb 2025, the cycle is chosen so that
+// the date falls between 3000 days before and 5500 days after ref. Factors
+// that fall in the remaining 500 days return ErrInvalidDueFactor.
+func BoletoDueDate(factor int, ref time.Time) (time.Time, error) {
+	if factor == 0 {
+		return time.Time{}, ErrNoDueDate
+	}
+	if factor < 1000 || factor > 9999 {
+		return time.Time{}, ErrInvalidDueFactor
+	}
+
+	start := dateOnly(ref).AddDate(0, 0, -boletoPastDays)
+	offset := int(start.Sub(boletoFactorBase).Hours() / 24)
+
+	cycle := offset / boletoFactorCycle
+	if offset < 0 {
+		cycle = 0
+	}
+	due := boletoFactorBase.AddDate(0, 0, cycle*boletoFactorCycle+factor-1000)
+	if due.Before(start) {
+		due = due.AddDate(0, 0, boletoFactorCycle)
+	}
+
+	if due.After(dateOnly(ref).AddDate(0, 0, boletoFutureDays)) {
+		return time.Time{}, ErrInvalidDueFactor
+	}
+
+	return due, nil
+}
+
+// BoletoDueFactor converts a due date to its due-date factor.
+func BoletoDueFactor(due time.Time) (int, error) {
+	days := int(dateOnly(due).Sub(boletoFactorBase).Hours() / 24)
+	if days < 0 {
+		return 0, ErrInvalidDueDate
+	}
+	return 1000 + days%boletoFactorCycle, nil
+}
+
+// DueDate returns the due date of the boleto relative to ref.
+func (b Boleto) DueDate(ref time.Time) (time.Time, error) {
+	return BoletoDueDate(b.DueFactor, ref)
+}
@@ -0,0 +1,85 @@
+package validatebr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleBoletoDueDate demonstrates how the due-date factor rolls over in February 2025.
+func ExampleBoletoDueDate() {
+	ref := time.Date(2025, time.March, 1, 0, 0, 0, 0, time.UTC)
+	for _, f := range []int{9999, 1000, 1001} {
+		due, _ := validatebr.BoletoDueDate(f, ref)
+		fmt.Println(f, due.Format("2006-01-02"))
+	}
+
+	// Output:
+	// 9999 2025-02-21
+	// 1000 2025-02-22
+	// 1001 2025-02-23
+}
+
+func TestBoletoDueDate(t *testing.T) {
+	tests := []struct {
+		name     string
+		factor   int
+		ref      string
+		expected string
+		err      error
+	}{
+		{name: "First factor of the first cycle", factor: 1000, ref: "2000-07-01", expected: "2000-07-03"},
+		{name: "Overdue before rollover", factor: 9000, ref: "2025-03-01", expected: "2022-05-29"},
+		{name: "Last factor of the first cycle", factor: 9999, ref: "2025-02-10", expected: "2025-02-21"},
+		{name: "Rollover seen before it happens", factor: 1000, ref: "2025-02-10", expected: "2025-02-22"},
+		{name: "Second cycle", factor: 1500, ref: "2025-06-01", expected: "2026-07-07"},
+		{name: "Old boleto in the first cycle", factor: 4000, ref: "2010-03-01", expected: "2008-09-19"},
+		{name: "Last day of the future window", factor: 6507, ref: "2025-03-01", expected: "2040-03-22"},
+		{name: "First factor after the future window", factor: 6508, ref: "2025-03-01", err: validatebr.ErrInvalidDueFactor},
+		{name: "Last factor before the past window", factor: 7006, ref: "2025-03-01", err: validatebr.ErrInvalidDueFactor},
+		{name: "First day of the past window", factor: 7007, ref: "2025-03-01", expected: "2016-12-13"},
+		{name: "No due date", factor: 0, ref: "2025-03-01", err: validatebr.ErrNoDueDate},
+		{name: "Legacy factor", factor: 999, ref: "2025-03-01", err: validatebr.ErrInvalidDueFactor},
+		{name: "Factor too large", factor: 10000, ref: "2025-03-01", err: validatebr.ErrInvalidDueFactor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ref, _ := time.Parse("2006-01-02", tt.ref)
+			due, err := validatebr.BoletoDueDate(tt.factor, ref)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("BoletoDueDate(%d, %s) error = %v; want %v", tt.factor, tt.ref, err, tt.err)
+			}
+			if err == nil && due.Format("2006-01-02") != tt.expected {
+				t.Errorf("BoletoDueDate(%d, %s) = %s; want %s", tt.factor, tt.ref, due.Format("2006-01-02"), tt.expected)
+			}
+		})
+	}
+}
+
+func TestBoletoDueFactor(t *testing.T) {
+	tests := []struct {
+		due      string
+		expected int
+		err      error
+	}{
+		{due: "2000-07-03", expected: 1000},
+		{due: "2025-02-21", expected: 9999},
+		{due: "2025-02-22", expected: 1000},
+		{due: "2026-07-07", expected: 1500},
+		{due: "2000-07-02", err: validatebr.ErrInvalidDueDate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.due, func(t *testing.T) {
+			due, _ := time.Parse("2006-01-02", tt.due)
+			factor, err := validatebr.BoletoDueFactor(due)
+			if factor != tt.expected || !errors.Is(err, tt.err) {
+				t.Errorf("BoletoDueFactor(%s) = %d, %v; want %d, %v", tt.due, factor, err, tt.expected, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package validatebr
+
+import "errors"
+
+// CFOPDirection tells whether a CFOP describes an incoming or outgoing
+// operation.
+type CFOPDirection int
+
+const (
+	CFOPEntrada CFOPDirection = iota + 1
+	CFOPSaida
+)
+
+// CFOPScope tells where the other party of a CFOP operation is.
+type CFOPScope int
+
+const (
+	CFOPInterna       CFOPScope = iota + 1 // same UF
+	CFOPInterestadual                      // another UF
+	CFOPExterior                           // abroad
+)
+
+var ErrInvalidCFOP = errors.New("invalid cfop")
+
+// CFOPInfo is a CFOP decomposed into its direction and scope.
+type CFOPInfo struct {
+	Code        string
+	Direction   CFOPDirection
+	Scope       CFOPScope
+	Description string
+}
+
+func (d CFOPDirection) String() string {
+	switch d {
+	case CFOPEntrada:
+		return "ENTRADA"
+	case CFOPSaida:
+		return "SAIDA"
+	}
+	return ""
+}
+
+func (s CFOPScope) String() string {
+	switch s {
+	case CFOPInterna:
+		return "INTERNA"
+	case CFOPInterestadual:
+		return "INTERESTADUAL"
+	case CFOPExterior:
+		return "EXTERIOR"
+	}
+	return ""
+}
+
+// ParseCFOP decomposes a 4-digit CFOP, formatted (5.102) or not, and
+// looks it up in the embedded CFOP table. The first digit gives the
+// direction and scope.
+func ParseCFOP(cfop string) (CFOPInfo, error) {
+	info, ok := parseCFOP(cfop)
+	if !ok {
+		return CFOPInfo{}, ErrInvalidCFOP
+	}
+
+	info.Description, ok = cfopDescriptions[info.Code]
+	if !ok {
+		return CFOPInfo{}, ErrInvalidCFOP
+	}
+	return info, nil
+}
+
+func parseCFOP(cfop string) (CFOPInfo, bool) {
+	cfop = RemoveNonDigits(cfop)
+	if len(cfop) != 4 || cfop[2:] == "00" {
+		return CFOPInfo{}, false
+	}
+
+	info := CFOPInfo{Code: cfop}
+	switch d := cfop[0]; {
+	case d >= '1' && d <= '3':
+		info.Direction = CFOPEntrada
+		info.Scope = CFOPScope(d - '0')
+	case d >= '5' && d <= '7':
+		info.Direction = CFOPSaida
+		info.Scope = CFOPScope(d - '4')
+	default:
+		return CFOPInfo{}, false
+	}
+
+	return info, true
+}
+
+// IsCFOP checks the format of a CFOP: direction and scope digit, and not a
+// group title (codes ending in 00). It does not check that the code exists
+// in the CFOP table.
+func IsCFOP(cfop string) bool {
+	_, ok := parseCFOP(cfop)
+	return ok
+}
+
+// CFOP validates a CFOP against the embedded CFOP table.
+func CFOP(cfop string) bool {
+	_, err := ParseCFOP(cfop)
+	return err == nil
+}
+
+// FormatCFOP formats a CFOP as 0.000.
+func FormatCFOP(cfop string) (string, error) {
+	cfop = RemoveNonDigits(cfop)
+	if len(cfop) != 4 {
+		return "", ErrInvalidLength
+	}
+	return applyMask(cfop, "#.###"), nil
+}
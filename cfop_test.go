@@ -0,0 +1,72 @@
+package validatebr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleParseCFOP demonstrates how to classify a CFOP.
+func ExampleParseCFOP() {
+	info, err := validatebr.ParseCFOP("6.102")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(info.Direction, info.Scope, info.Description)
+
+	// Output:
+	// SAIDA INTERESTADUAL Venda de mercadoria adquirida ou recebida de terceiros
+}
+
+func TestParseCFOP(t *testing.T) {
+	tests := []struct {
+		input     string
+		direction validatebr.CFOPDirection
+		scope     validatebr.CFOPScope
+		format    bool
+		err       error
+	}{
+		{input: "1102", direction: validatebr.CFOPEntrada, scope: validatebr.CFOPInterna, format: true},
+		{input: "2.556", direction: validatebr.CFOPEntrada, scope: validatebr.CFOPInterestadual, format: true},
+		{input: "3102", direction: validatebr.CFOPEntrada, scope: validatebr.CFOPExterior, format: true},
+		{input: "5.405", direction: validatebr.CFOPSaida, scope: validatebr.CFOPInterna, format: true},
+		{input: "6108", direction: validatebr.CFOPSaida, scope: validatebr.CFOPInterestadual, format: true},
+		{input: "7101", direction: validatebr.CFOPSaida, scope: validatebr.CFOPExterior, format: true},
+		{input: "5.929", direction: validatebr.CFOPSaida, scope: validatebr.CFOPInterna, format: true},
+		{input: "1850", format: true, err: validatebr.ErrInvalidCFOP},
+		{input: "5999", format: true, err: validatebr.ErrInvalidCFOP},
+		{input: "5107", format: true, err: validatebr.ErrInvalidCFOP},
+		{input: "6927", format: true, err: validatebr.ErrInvalidCFOP},
+		{input: "4102", err: validatebr.ErrInvalidCFOP},
+		{input: "8102", err: validatebr.ErrInvalidCFOP},
+		{input: "5100", err: validatebr.ErrInvalidCFOP},
+		{input: "510", err: validatebr.ErrInvalidCFOP},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			info, err := validatebr.ParseCFOP(tt.input)
+			if err != tt.err || info.Direction != tt.direction || info.Scope != tt.scope {
+				t.Errorf("ParseCFOP(%q) = %+v, %v; want %v %v, %v", tt.input, info, err, tt.direction, tt.scope, tt.err)
+			}
+			if err == nil && info.Description == "" {
+				t.Errorf("ParseCFOP(%q) has no description", tt.input)
+			}
+			if validatebr.CFOP(tt.input) != (tt.err == nil) {
+				t.Errorf("CFOP(%q) = %v; want %v", tt.input, !(tt.err == nil), tt.err == nil)
+			}
+			if validatebr.IsCFOP(tt.input) != tt.format {
+				t.Errorf("IsCFOP(%q) = %v; want %v", tt.input, !tt.format, tt.format)
+			}
+		})
+	}
+}
+
+func TestFormatCFOP(t *testing.T) {
+	cfop, err := validatebr.FormatCFOP("5102")
+	if err != nil || cfop != "5.102" {
+		t.Errorf("FormatCFOP() = %q, %v; want %q", cfop, err, "5.102")
+	}
+}
@@ -0,0 +1,114 @@
+package validatebr
+
+//go:generate go run ./processCNAETable -o cnae_table.go
+
+// cnaeSections lists the 21 CNAE 2.3 sections with the range of divisions
+// each one covers.
+var cnaeSections = []struct {
+	letter      string
+	first, last string
+	description string
+}{
+	{"A", "01", "03", "Agricultura, pecuária, produção florestal, pesca e aqüicultura"},
+	{"B", "05", "09", "Indústrias extrativas"},
+	{"C", "10", "33", "Indústrias de transformação"},
+	{"D", "35", "35", "Eletricidade e gás"},
+	{"E", "36", "39", "Água, esgoto, atividades de gestão de resíduos e descontaminação"},
+	{"F", "41", "43", "Construção"},
+	{"G", "45", "47", "Comércio; reparação de veículos automotores e motocicletas"},
+	{"H", "49", "53", "Transporte, armazenagem e correio"},
+	{"I", "55", "56", "Alojamento e alimentação"},
+	{"J", "58", "63", "Informação e comunicação"},
+	{"K", "64", "66", "Atividades financeiras, de seguros e serviços relacionados"},
+	{"L", "68", "68", "Atividades imobiliárias"},
+	{"M", "69", "75", "Atividades profissionais, científicas e técnicas"},
+	{"N", "77", "82", "Atividades administrativas e serviços complementares"},
+	{"O", "84", "84", "Administração pública, defesa e seguridade social"},
+	{"P", "85", "85", "Educação"},
+	{"Q", "86", "88", "Saúde humana e serviços sociais"},
+	{"R", "90", "93", "Artes, cultura, esporte e recreação"},
+	{"S", "94", "96", "Outras atividades de serviços"},
+	{"T", "97", "97", "Serviços domésticos"},
+	{"U", "99", "99", "Organismos internacionais e outras instituições extraterritoriais"},
+}
+
+// CNAELevel is one level of the CNAE hierarchy, with the code in its
+// official notation, such as "G", "47", "47.1", "47.11-3" or "4711-3/01".
+type CNAELevel struct {
+	Code        string
+	Description string
+}
+
+// CNAEActivity is a CNAE 2.3 subclass together with the levels above it.
+type CNAEActivity struct {
+	Section  CNAELevel
+	Division CNAELevel
+	Group    CNAELevel
+	Class    CNAELevel
+	Subclass CNAELevel
+}
+
+// cnaeSection returns the section of a 2-digit division.
+func cnaeSection(division string) (CNAELevel, bool) {
+	for _, s := range cnaeSections {
+		if division >= s.first && division <= s.last {
+			return CNAELevel{Code: s.letter, Description: s.description}, true
+		}
+	}
+	return CNAELevel{}, false
+}
+
+// FormatCNAE formats a 7-digit CNAE subclass as 0000-0/00.
+func FormatCNAE(code string) (string, error) {
+	code = RemoveNonDigits(code)
+	if len(code) != 7 {
+		return "", ErrInvalidLength
+	}
+	return applyMask(code, "####-#/##"), nil
+}
+
+// IsCNAE checks the format of a CNAE subclass, formatted or not: 7 digits
+// whose division belongs to a CNAE 2.3 section. It does not check that
+// the subclass exists.
+func IsCNAE(code string) bool {
+	code = RemoveNonDigits(code)
+	if len(code) != 7 {
+		return false
+	}
+	_, ok := cnaeSection(code[:2])
+	return ok
+}
+
+// CNAE validates a CNAE 2.3 subclass, formatted or not, against the
+// embedded subclass table.
+func CNAE(code string) bool {
+	_, ok := CNAEByCode(code)
+	return ok
+}
+
+// CNAEByCode looks up a CNAE 2.3 subclass and its hierarchy in the
+// embedded table.
+func CNAEByCode(code string) (CNAEActivity, bool) {
+	code = RemoveNonDigits(code)
+	if len(code) != 7 {
+		return CNAEActivity{}, false
+	}
+
+	subclass, ok := cnaeDescriptions[code]
+	if !ok {
+		return CNAEActivity{}, false
+	}
+
+	section, ok := cnaeSection(code[:2])
+	if !ok {
+		return CNAEActivity{}, false
+	}
+
+	return CNAEActivity{
+		Section:  section,
+		Division: CNAELevel{Code: code[:2], Description: cnaeDescriptions[code[:2]]},
+		Group:    CNAELevel{Code: applyMask(code[:3], "##.#"), Description: cnaeDescriptions[code[:3]]},
+		Class:    CNAELevel{Code: applyMask(code[:5], "##.##-#"), Description: cnaeDescriptions[code[:5]]},
+		Subclass: CNAELevel{Code: applyMask(code, "####-#/##"), Description: subclass},
+	}, true
+}
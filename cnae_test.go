@@ -0,0 +1,152 @@
+package validatebr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleCNAEByCode demonstrates how to look up the hierarchy of a CNAE subclass.
+func ExampleCNAEByCode() {
+	a, ok := validatebr.CNAEByCode("6201-5/01")
+	if !ok {
+		return
+	}
+
+	fmt.Println(a.Section.Code, a.Section.Description)
+	fmt.Println(a.Division.Code, a.Division.Description)
+	fmt.Println(a.Group.Code, a.Group.Description)
+	fmt.Println(a.Class.Code, a.Class.Description)
+	fmt.Println(a.Subclass.Code, a.Subclass.Description)
+
+	// Output:
+	// J Informação e comunicação
+	// 62 Atividades dos serviços de tecnologia da informação
+	// 62.0 Atividades dos serviços de tecnologia da informação
+	// 62.01-5 Desenvolvimento de programas de computador sob encomenda
+	// 6201-5/01 Desenvolvimento de programas de computador sob encomenda
+}
+
+func TestIsCNAE(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "Valid formatted subclass",
+			input:    "4711-3/02",
+			expected: true,
+		},
+		{
+			name:     "Valid unformatted subclass",
+			input:    "0111301",
+			expected: true,
+		},
+		{
+			name:     "Subclass outside the description table",
+			input:    "0113-0/00",
+			expected: true,
+		},
+		{
+			name:     "Division outside every section",
+			input:    "0400000",
+			expected: false,
+		},
+		{
+			name:     "Class code is not a subclass",
+			input:    "47113",
+			expected: false,
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.IsCNAE(tt.input)
+			if result != tt.expected {
+				t.Errorf("IsCNAE(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCNAE(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "Valid formatted subclass",
+			input:    "4711-3/02",
+			expected: true,
+		},
+		{
+			name:     "Valid unformatted subclass",
+			input:    "6201501",
+			expected: true,
+		},
+		{
+			name:     "Class code is not a subclass",
+			input:    "47113",
+			expected: false,
+		},
+		{
+			name:     "Unknown subclass",
+			input:    "4711-3/99",
+			expected: false,
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.CNAE(tt.input)
+			if result != tt.expected {
+				t.Errorf("CNAE(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestCNAEHierarchy(t *testing.T) {
+	// every subclass in the table must have all the levels above it.
+	for _, code := range []string{
+		"0111301", "0115600", "0151202", "1091102", "4120400", "4330499",
+		"4520001", "4712100", "4771701", "4781400", "4930202", "5611204",
+		"6202300", "6311900", "6422100", "6920601", "7020400", "8211300",
+		"8599604", "8630504", "9602501",
+	} {
+		a, ok := validatebr.CNAEByCode(code)
+		if !ok {
+			t.Errorf("CNAEByCode(%q) not found", code)
+			continue
+		}
+		for _, l := range []validatebr.CNAELevel{a.Section, a.Division, a.Group, a.Class, a.Subclass} {
+			if l.Code == "" || l.Description == "" {
+				t.Errorf("CNAEByCode(%q) has an empty level: %+v", code, a)
+			}
+		}
+	}
+}
+
+func TestFormatCNAE(t *testing.T) {
+	code, err := validatebr.FormatCNAE("4711302")
+	if err != nil || code != "4711-3/02" {
+		t.Errorf("FormatCNAE() = %q, %v; want %q", code, err, "4711-3/02")
+	}
+
+	if _, err := validatebr.FormatCNAE("47113"); err != validatebr.ErrInvalidLength {
+		t.Errorf("FormatCNAE() error = %v; want %v", err, validatebr.ErrInvalidLength)
+	}
+}
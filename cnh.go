@@ -0,0 +1,86 @@
+package validatebr
+
+import (
+	"regexp"
+	"strings"
+)
+
+var (
+	cnhP1 = []int{9, 8, 7, 6, 5, 4, 3, 2, 1}
+	cnhP2 = []int{1, 2, 3, 4, 5, 6, 7, 8, 9}
+
+	renachRegex = regexp.MustCompile(`^[A-Z]{2}[0-9]{9}$`)
+)
+
+// cnhDigits returns the check digits for the first 9 digits of a CNH
+// registro, or false when the base cannot produce a valid number.
+func cnhDigits(base string) (int, int, bool) {
+	dsc := 0
+	d1 := sum(base, cnhP1) % 11
+	if d1 >= 10 {
+		d1 = 0
+		dsc = 2
+	}
+
+	d2 := sum(base, cnhP2) % 11
+	if d2 >= 10 {
+		d2 = 0
+	} else {
+		d2 -= dsc
+	}
+
+	return d1, d2, d2 >= 0
+}
+
+// CNH validates the 11-digit registro number of a CNH (driver's licence).
+func CNH(cnh string) bool {
+	cnh = RemoveNonDigits(cnh)
+	if len(cnh) != 11 {
+		return false
+	}
+
+	if IsRepetitive(cnh) {
+		return false
+	}
+
+	d1, d2, ok := cnhDigits(cnh[:9])
+	if !ok {
+		return false
+	}
+
+	return int(cnh[9]-'0') == d1 && int(cnh[10]-'0') == d2
+}
+
+// FormatCNH normalises a CNH registro to 11 digits, left-padding older
+// numbers with zeros.
+func FormatCNH(cnh string) (string, error) {
+	cnh = RemoveNonDigits(cnh)
+	if len(cnh) == 0 || len(cnh) > 11 {
+		return "", ErrInvalidLength
+	}
+	return strings.Repeat("0", 11-len(cnh)) + cnh, nil
+}
+
+// GenerateCNH returns a random valid CNH registro, for tests.
+func GenerateCNH() string {
+	for {
+		base := randomDigits(9)
+		d1, d2, ok := cnhDigits(base)
+		if !ok || IsRepetitive(base) {
+			continue
+		}
+		return base + string(byte('0'+d1)) + string(byte('0'+d2))
+	}
+}
+
+// RENACH validates the format of a RENACH identifier: the issuing UF
+// followed by 9 digits. RENACH has no public check digit.
+func RENACH(renach string) bool {
+	renach = strings.ToUpper(RemoveNonAlphaNum(renach))
+	if !renachRegex.MatchString(renach) {
+		return false
+	}
+
+	_, err := ParseUF(renach[:2])
+	return err == nil
+}
@@ -0,0 +1,136 @@
+package validatebr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleCNH demonstrates how to validate a CNH registro number.
+func ExampleCNH() {
+	cnhs := []string{
+		"02650306461",
+		"02650306462", // digit mismatch
+	}
+
+	for _, c := range cnhs {
+		fmt.Printf("%s -> %v\n", c, validatebr.CNH(c))
+	}
+
+	// Output:
+	// 02650306461 -> true
+	// 02650306462 -> false
+}
+
+func TestCNH(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "Valid CNH",
+			input:    "02650306461",
+			expected: true,
+		},
+		{
+			name:     "Valid CNH with mask",
+			input:    "976.256.556-78",
+			expected: true,
+		},
+		{
+			name:     "Valid CNH with second digit decrement",
+			input:    "10000004401",
+			expected: true,
+		},
+		{
+			name:     "Base without a valid second digit",
+			input:    "10000002800",
+			expected: false,
+		},
+		{
+			name:     "Invalid check digit",
+			input:    "97625655679",
+			expected: false,
+		},
+		{
+			name:     "All repetitive digits",
+			input:    "11111111111",
+			expected: false,
+		},
+		{
+			name:     "Invalid length",
+			input:    "0265030646",
+			expected: false,
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.CNH(tt.input)
+			if result != tt.expected {
+				t.Errorf("CNH(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatCNH(t *testing.T) {
+	cnh, err := validatebr.FormatCNH("265030646-1")
+	if err != nil || cnh != "02650306461" {
+		t.Errorf("FormatCNH() = %q, %v; want %q", cnh, err, "02650306461")
+	}
+}
+
+func TestGenerateCNH(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		cnh := validatebr.GenerateCNH()
+		if !validatebr.CNH(cnh) {
+			t.Fatalf("GenerateCNH() = %q; not a valid CNH", cnh)
+		}
+	}
+}
+
+func TestRENACH(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "Valid RENACH",
+			input:    "SP123456789",
+			expected: true,
+		},
+		{
+			name:     "Valid RENACH in lowercase with separator",
+			input:    "pr-987654321",
+			expected: true,
+		},
+		{
+			name:     "Unknown UF",
+			input:    "XX123456789",
+			expected: false,
+		},
+		{
+			name:     "Invalid length",
+			input:    "SP12345678",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.RENACH(tt.input)
+			if result != tt.expected {
+				t.Errorf("RENACH(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package validatebr
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+)
+
+// JusticeSegment is the J field of a CNJ lawsuit number.
+type JusticeSegment int
+
+const (
+	JusticeSTF JusticeSegment = iota + 1
+	JusticeCNJ
+	JusticeSTJ
+	JusticeFederal
+	JusticeTrabalho
+	JusticeEleitoral
+	JusticeMilitarUniao
+	JusticeEstadual
+	JusticeMilitarEstadual
+)
+
+var ErrInvalidCNJ = errors.New("invalid cnj lawsuit number")
+
+func (s JusticeSegment) String() string {
+	switch s {
+	case JusticeSTF:
+		return "Supremo Tribunal Federal"
+	case JusticeCNJ:
+		return "Conselho Nacional de Justiça"
+	case JusticeSTJ:
+		return "Superior Tribunal de Justiça"
+	case JusticeFederal:
+		return "Justiça Federal"
+	case JusticeTrabalho:
+		return "Justiça do Trabalho"
+	case JusticeEleitoral:
+		return "Justiça Eleitoral"
+	case JusticeMilitarUniao:
+		return "Justiça Militar da União"
+	case JusticeEstadual:
+		return "Justiça dos Estados e do Distrito Federal e Territórios"
+	case JusticeMilitarEstadual:
+		return "Justiça Militar Estadual"
+	}
+	return ""
+}
+
+// CNJNumber is a lawsuit number in the unified format defined by CNJ
+// Resolução 65/2008: NNNNNNN-DD.AAAA.J.TR.OOOO.
+type CNJNumber struct {
+	Sequential  int
+	CheckDigits int
+	Year        int
+	Segment     JusticeSegment
+	Tribunal    int
+	Origin      int
+}
+
+// cnjCheckDigits computes DD for the 18 digits NNNNNNNAAAAJTROOOO.
+func cnjCheckDigits(base string) int {
+	return 98 - ibanMod97(base+"00")
+}
+
+// ParseCNJ validates the check digits of a CNJ lawsuit number, formatted
+// or not, and decomposes it.
+func ParseCNJ(number string) (CNJNumber, error) {
+	number = RemoveNonDigits(number)
+	if len(number) != 20 {
+		return CNJNumber{}, fmt.Errorf("%w: invalid length", ErrInvalidCNJ)
+	}
+
+	dd, _ := strconv.Atoi(number[7:9])
+	if dd != cnjCheckDigits(number[:7]+number[9:]) {
+		return CNJNumber{}, fmt.Errorf("%w: check digits mismatch", ErrInvalidCNJ)
+	}
+
+	var n CNJNumber
+	n.Sequential, _ = strconv.Atoi(number[:7])
+	n.CheckDigits = dd
+	n.Year, _ = strconv.Atoi(number[9:13])
+	n.Segment = JusticeSegment(number[13] - '0')
+	n.Tribunal, _ = strconv.Atoi(number[14:16])
+	n.Origin, _ = strconv.Atoi(number[16:])
+
+	if err := n.validate(); err != nil {
+		return CNJNumber{}, err
+	}
+
+	return n, nil
+}
+
+func (n CNJNumber) validate() error {
+	switch {
+	case n.Sequential < 0 || n.Sequential > 9999999:
+		return fmt.Errorf("%w: invalid sequential number", ErrInvalidCNJ)
+	case n.Year < 1000 || n.Year > 9999:
+		return fmt.Errorf("%w: invalid year", ErrInvalidCNJ)
+	case n.Segment.String() == "":
+		return fmt.Errorf("%w: invalid justice segment", ErrInvalidCNJ)
+	case n.Tribunal < 0 || n.Tribunal > 99:
+		return fmt.Errorf("%w: invalid tribunal", ErrInvalidCNJ)
+	case n.Origin < 0 || n.Origin > 9999:
+		return fmt.Errorf("%w: invalid origin", ErrInvalidCNJ)
+	}
+	return nil
+}
+
+// CNJ validates a lawsuit number in the CNJ unified format.
+func CNJ(number string) bool {
+	_, err := ParseCNJ(number)
+	return err == nil
+}
+
+// FormatCNJ formats a 20-digit lawsuit number as NNNNNNN-DD.AAAA.J.TR.OOOO.
+func FormatCNJ(number string) (string, error) {
+	number = RemoveNonDigits(number)
+	if len(number) != 20 {
+		return "", ErrInvalidLength
+	}
+	return applyMask(number, "#######-##.####.#.##.####"), nil
+}
+
+// BuildCNJ computes the check digits of n, ignoring n.CheckDigits, and
+// returns the formatted lawsuit number.
+func BuildCNJ(n CNJNumber) (string, error) {
+	if err := n.validate(); err != nil {
+		return "", err
+	}
+
+	base := fmt.Sprintf("%07d%04d%d%02d%04d", n.Sequential, n.Year, n.Segment, n.Tribunal, n.Origin)
+	number := fmt.Sprintf("%s%02d%s", base[:7], cnjCheckDigits(base), base[7:])
+
+	return applyMask(number, "#######-##.####.#.##.####"), nil
+}
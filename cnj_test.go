@@ -0,0 +1,102 @@
+package validatebr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleParseCNJ demonstrates how to decompose a CNJ lawsuit number.
+func ExampleParseCNJ() {
+	n, err := validatebr.ParseCNJ("0001327-64.2018.8.26.0158")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(n.Sequential, n.CheckDigits, n.Year, n.Tribunal, n.Origin)
+	fmt.Println(n.Segment)
+
+	// Output:
+	// 1327 64 2018 26 158
+	// Justiça dos Estados e do Distrito Federal e Territórios
+}
+
+func TestCNJ(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "Valid formatted number",
+			input:    "0001327-64.2018.8.26.0158",
+			expected: true,
+		},
+		{
+			name:     "Valid unformatted number",
+			input:    "50012344220204036100",
+			expected: true,
+		},
+		{
+			name:     "Invalid check digits",
+			input:    "0001327-65.2018.8.26.0158",
+			expected: false,
+		},
+		{
+			name:     "Invalid justice segment",
+			input:    "0000001-91.2013.0.26.0100",
+			expected: false,
+		},
+		{
+			name:     "Invalid length",
+			input:    "0001327-64.2018.8.26.015",
+			expected: false,
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.CNJ(tt.input)
+			if result != tt.expected {
+				t.Errorf("CNJ(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestBuildCNJ(t *testing.T) {
+	number, err := validatebr.BuildCNJ(validatebr.CNJNumber{
+		Sequential: 1000123,
+		Year:       2023,
+		Segment:    validatebr.JusticeTrabalho,
+		Tribunal:   2,
+		Origin:     1,
+	})
+	if err != nil || number != "1000123-93.2023.5.02.0001" {
+		t.Errorf("BuildCNJ() = %q, %v; want %q", number, err, "1000123-93.2023.5.02.0001")
+	}
+
+	n, err := validatebr.ParseCNJ(number)
+	if err != nil || n.CheckDigits != 93 || n.Segment != validatebr.JusticeTrabalho {
+		t.Errorf("ParseCNJ(%q) = %+v, %v", number, n, err)
+	}
+
+	_, err = validatebr.BuildCNJ(validatebr.CNJNumber{Sequential: 10000000, Year: 2023, Segment: validatebr.JusticeFederal})
+	if !errors.Is(err, validatebr.ErrInvalidCNJ) {
+		t.Errorf("BuildCNJ() error = %v; want %v", err, validatebr.ErrInvalidCNJ)
+	}
+}
+
+func TestFormatCNJ(t *testing.T) {
+	number, err := validatebr.FormatCNJ("00013276420188260158")
+	if err != nil || number != "0001327-64.2018.8.26.0158" {
+		t.Errorf("FormatCNJ() = %q, %v; want %q", number, err, "0001327-64.2018.8.26.0158")
+	}
+}
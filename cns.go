@@ -0,0 +1,67 @@
+package validatebr
+
+import "math/rand/v2"
+
+var cnsP = []int{15, 14, 13, 12, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2, 1}
+
+// cnsDefinitive builds a definitive CNS from its first 11 digits, which are
+// derived from the holder's PIS.
+func cnsDefinitive(base string) string {
+	s := sum(base, cnsP[:11])
+	d := 11 - s%11
+	if d == 11 {
+		d = 0
+	}
+	if d == 10 {
+		s += 2
+		d = 11 - s%11
+		return base + "001" + string(byte('0'+d))
+	}
+	return base + "000" + string(byte('0'+d))
+}
+
+// CNS validates a Cartão Nacional de Saúde number, either definitive
+// (starting with 1 or 2) or provisional (starting with 7, 8 or 9).
+func CNS(cns string) bool {
+	cns = RemoveNonDigits(cns)
+	if len(cns) != 15 {
+		return false
+	}
+
+	if IsRepetitive(cns) {
+		return false
+	}
+
+	switch cns[0] {
+	case '1', '2':
+		return cnsDefinitive(cns[:11]) == cns
+	case '7', '8', '9':
+		return sum(cns, cnsP)%11 == 0
+	}
+
+	return false
+}
+
+// FormatCNS formats a CNS number as 000 0000 0000 0000.
+func FormatCNS(cns string) (string, error) {
+	cns = RemoveNonDigits(cns)
+	if len(cns) != 15 {
+		return "", ErrInvalidLength
+	}
+	return applyMask(cns, "### #### #### ####"), nil
+}
+
+// GenerateCNS returns a random valid CNS number, for tests.
+func GenerateCNS() string {
+	if rand.IntN(2) == 0 {
+		return cnsDefinitive(string(byte('1'+rand.IntN(2))) + randomDigits(10))
+	}
+
+	for {
+		base := string(byte('7'+rand.IntN(3))) + randomDigits(13)
+		d := (11 - sum(base, cnsP[:14])%11) % 11
+		if d < 10 {
+			return base + string(byte('0'+d))
+		}
+	}
+}
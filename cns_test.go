@@ -0,0 +1,105 @@
+package validatebr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleCNS demonstrates how to validate a Cartão Nacional de Saúde number.
+func ExampleCNS() {
+	cnss := []string{
+		"170 3325 9504 0000", // definitive
+		"700 0000 0000 0005", // provisional
+		"700 0000 0000 0006", // invalid
+	}
+
+	for _, c := range cnss {
+		fmt.Printf("%s -> %v\n", c, validatebr.CNS(c))
+	}
+
+	// Output:
+	// 170 3325 9504 0000 -> true
+	// 700 0000 0000 0005 -> true
+	// 700 0000 0000 0006 -> false
+}
+
+func TestCNS(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "Valid definitive CNS",
+			input:    "170332595040000",
+			expected: true,
+		},
+		{
+			name:     "Valid definitive CNS with 001 adjustment",
+			input:    "100 0000 0006 0018",
+			expected: true,
+		},
+		{
+			name:     "Definitive CNS with wrong middle digits",
+			input:    "100 0000 0006 0108",
+			expected: false,
+		},
+		{
+			name:     "Valid provisional CNS",
+			input:    "700 0000 0000 0005",
+			expected: true,
+		},
+		{
+			name:     "Invalid provisional CNS",
+			input:    "800 0000 0000 0005",
+			expected: false,
+		},
+		{
+			name:     "Invalid first digit",
+			input:    "300 0000 0000 0005",
+			expected: false,
+		},
+		{
+			name:     "All repetitive digits",
+			input:    "777777777777777",
+			expected: false,
+		},
+		{
+			name:     "Invalid length",
+			input:    "70000000000000",
+			expected: false,
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.CNS(tt.input)
+			if result != tt.expected {
+				t.Errorf("CNS(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatCNS(t *testing.T) {
+	cns, err := validatebr.FormatCNS("700000000000005")
+	if err != nil || cns != "700 0000 0000 0005" {
+		t.Errorf("FormatCNS() = %q, %v; want %q", cns, err, "700 0000 0000 0005")
+	}
+}
+
+func TestGenerateCNS(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		cns := validatebr.GenerateCNS()
+		if !validatebr.CNS(cns) {
+			t.Fatalf("GenerateCNS() = %q; not a valid CNS", cns)
+		}
+	}
+}
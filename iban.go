@@ -0,0 +1,83 @@
+package validatebr
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	ibanRegex = regexp.MustCompile(`^BR[0-9]{2}[0-9]{8}[0-9]{5}[0-9]{10}[A-Z][0-9A-Z]$`)
+
+	ErrInvalidIBAN = errors.New("invalid iban")
+)
+
+// IBAN holds the fields of a Brazilian IBAN.
+type IBAN struct {
+	ISPB        string // 8 digits
+	Branch      string // 5 digits
+	Account     string // 10 digits, including the account check digit
+	AccountType string // C for checking, P for savings
+	Owner       string // 1 for the first holder, 2 onwards for the others
+}
+
+// ibanMod97 computes the ISO 7064 modulo 97 of a string, with letters
+// valued from 10 to 35.
+func ibanMod97(s string) int {
+	r := 0
+	for _, c := range s {
+		if c >= 'A' && c <= 'Z' {
+			r = (r*100 + int(c-'A') + 10) % 97
+			continue
+		}
+		r = (r*10 + int(c-'0')) % 97
+	}
+	return r
+}
+
+// ParseIBAN validates a Brazilian IBAN, including its modulo 97 check
+// digits, and decomposes it.
+func ParseIBAN(iban string) (IBAN, error) {
+	iban = strings.ToUpper(RemoveNonAlphaNum(iban))
+	if !ibanRegex.MatchString(iban) {
+		return IBAN{}, ErrInvalidIBAN
+	}
+
+	if ibanMod97(iban[4:]+iban[:4]) != 1 {
+		return IBAN{}, ErrInvalidIBAN
+	}
+
+	return IBAN{
+		ISPB:        iban[4:12],
+		Branch:      iban[12:17],
+		Account:     iban[17:27],
+		AccountType: iban[27:28],
+		Owner:       iban[28:29],
+	}, nil
+}
+
+// BuildIBAN returns the IBAN for the given parts, left-padding branch and
+// account with zeros.
+func BuildIBAN(i IBAN) (string, error) {
+	branch, err := padDigits(RemoveNonAlphaNum(i.Branch), 5)
+	if err != nil {
+		return "", ErrInvalidIBAN
+	}
+	account, err := padDigits(RemoveNonAlphaNum(i.Account), 10)
+	if err != nil {
+		return "", ErrInvalidIBAN
+	}
+
+	bban := i.ISPB + branch + account + strings.ToUpper(i.AccountType+i.Owner)
+	if !ibanRegex.MatchString("BR00" + bban) {
+		return "", ErrInvalidIBAN
+	}
+
+	return fmt.Sprintf("BR%02d%s", 98-ibanMod97(bban+"BR00"), bban), nil
+}
+
+// Bank returns the institution identified by the IBAN's ISPB.
+func (i IBAN) Bank() (Bank, bool) {
+	return BankByISPB(i.ISPB)
+}
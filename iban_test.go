@@ -0,0 +1,123 @@
+package validatebr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleBuildIBAN demonstrates how to build a Brazilian IBAN from its parts.
+func ExampleBuildIBAN() {
+	iban, err := validatebr.BuildIBAN(validatebr.IBAN{
+		ISPB:        "00360305",
+		Branch:      "1",
+		Account:     "0009795493",
+		AccountType: "C",
+		Owner:       "1",
+	})
+	fmt.Println(iban, err)
+
+	// Output:
+	// BR1800360305000010009795493C1 <nil>
+}
+
+func TestParseIBAN(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected validatebr.IBAN
+		err      error
+	}{
+		{
+			name:     "Valid IBAN with spaces",
+			input:    "BR18 0036 0305 0000 1000 9795 493C 1",
+			expected: validatebr.IBAN{ISPB: "00360305", Branch: "00001", Account: "0009795493", AccountType: "C", Owner: "1"},
+		},
+		{
+			name:     "Valid savings IBAN in lowercase",
+			input:    "br1500000000000010932840814p2",
+			expected: validatebr.IBAN{ISPB: "00000000", Branch: "00001", Account: "0932840814", AccountType: "P", Owner: "2"},
+		},
+		{
+			name:  "Invalid check digits",
+			input: "BR1900360305000010009795493C1",
+			err:   validatebr.ErrInvalidIBAN,
+		},
+		{
+			name:  "Other country",
+			input: "DE89370400440532013000",
+			err:   validatebr.ErrInvalidIBAN,
+		},
+		{
+			name:  "Invalid length",
+			input: "BR1800360305000010009795493C",
+			err:   validatebr.ErrInvalidIBAN,
+		},
+		{
+			name:  "Empty string",
+			input: "",
+			err:   validatebr.ErrInvalidIBAN,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validatebr.ParseIBAN(tt.input)
+			if result != tt.expected || !errors.Is(err, tt.err) {
+				t.Errorf("ParseIBAN(%q) = %+v, %v; want %+v, %v", tt.input, result, err, tt.expected, tt.err)
+			}
+		})
+	}
+}
+
+func TestBuildIBAN(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    validatebr.IBAN
+		expected string
+		err      error
+	}{
+		{
+			name:     "Savings account",
+			input:    validatebr.IBAN{ISPB: "00000000", Branch: "00001", Account: "093284081-4", AccountType: "p", Owner: "2"},
+			expected: "BR1500000000000010932840814P2",
+		},
+		{
+			name:  "Invalid ISPB",
+			input: validatebr.IBAN{ISPB: "360305", Branch: "1", Account: "9795493", AccountType: "C", Owner: "1"},
+			err:   validatebr.ErrInvalidIBAN,
+		},
+		{
+			name:  "Account too long",
+			input: validatebr.IBAN{ISPB: "00360305", Branch: "1", Account: "12345678901", AccountType: "C", Owner: "1"},
+			err:   validatebr.ErrInvalidIBAN,
+		},
+		{
+			name:  "Missing account type",
+			input: validatebr.IBAN{ISPB: "00360305", Branch: "1", Account: "9795493", Owner: "1"},
+			err:   validatebr.ErrInvalidIBAN,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validatebr.BuildIBAN(tt.input)
+			if result != tt.expected || !errors.Is(err, tt.err) {
+				t.Errorf("BuildIBAN(%+v) = %q, %v; want %q, %v", tt.input, result, err, tt.expected, tt.err)
+			}
+		})
+	}
+}
+
+func TestIBANBank(t *testing.T) {
+	i, err := validatebr.ParseIBAN("BR1800360305000010009795493C1")
+	if err != nil {
+		t.Fatalf("ParseIBAN() error = %v", err)
+	}
+	b, ok := i.Bank()
+	if !ok || b.COMPE != "104" {
+		t.Errorf("Bank() = %+v, %v; want COMPE 104", b, ok)
+	}
+}
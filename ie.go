@@ -0,0 +1,391 @@
+package validatebr
+
+import "strings"
+
+var (
+	ieP2to9  = []int{9, 8, 7, 6, 5, 4, 3, 2}
+	ieP2to10 = []int{10, 9, 8, 7, 6, 5, 4, 3, 2}
+	ieP13a   = []int{4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+	ieP13b   = []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+	// ieRules maps each UF to the validation of its inscrição estadual,
+	// which receives only the digits (and the P prefix for SP).
+	ieRules = map[UF]func(string) bool{
+		AC: ieAC,
+		AL: ieAL,
+		AM: ieAM,
+		AP: ieAP,
+		BA: ieBA,
+		CE: ieMod11,
+		DF: ieDF,
+		ES: ieMod11,
+		GO: ieGO,
+		MA: iePrefix("12", ieMod11),
+		MG: ieMG,
+		MS: ieMS,
+		MT: ieMT,
+		PA: iePrefix("15", ieMod11),
+		PB: ieMod11,
+		PE: iePE,
+		PI: ieMod11,
+		PR: iePR,
+		RJ: ieRJ,
+		RN: ieRN,
+		RO: ieRO,
+		RR: ieRR,
+		RS: ieRS,
+		SC: ieMod11,
+		SE: ieMod11,
+		SP: ieSP,
+		TO: ieTO,
+	}
+)
+
+// InscricaoEstadual validates a state registration for the given UF, in
+// any form accepted by ParseUF. "ISENTO" is accepted for every UF.
+func InscricaoEstadual(ie, uf string) bool {
+	u, err := ParseUF(uf)
+	if err != nil {
+		return false
+	}
+	rule := ieRules[u]
+
+	ie = strings.ToUpper(strings.TrimSpace(ie))
+	if ie == "ISENTO" {
+		return true
+	}
+
+	digits := RemoveNonDigits(ie)
+	if len(digits) == 0 || IsRepetitive(digits) {
+		return false
+	}
+
+	if strings.HasPrefix(ie, "P") {
+		if u != SP {
+			return false
+		}
+		digits = "P" + digits
+	}
+
+	for i, c := range ie {
+		if c >= '0' && c <= '9' || c == 'P' && i == 0 ||
+			c == '.' || c == '-' || c == '/' || c == ' ' {
+			continue
+		}
+		return false
+	}
+
+	return rule(digits)
+}
+
+// ieDigit computes the usual modulo 11 check digit, where remainders 0
+// and 1 give 0.
+func ieDigit(s string, table []int) int {
+	r := sum(s, table) % 11
+	if r < 2 {
+		return 0
+	}
+	return 11 - r
+}
+
+func digitAt(s string, i int) int {
+	return int(s[i] - '0')
+}
+
+func iePrefix(prefix string, rule func(string) bool) func(string) bool {
+	return func(ie string) bool {
+		return strings.HasPrefix(ie, prefix) && rule(ie)
+	}
+}
+
+// ieMod11 is shared by the states using 8 digits plus a modulo 11 digit.
+func ieMod11(ie string) bool {
+	if len(ie) != 9 {
+		return false
+	}
+	return digitAt(ie, 8) == ieDigit(ie[:8], ieP2to9)
+}
+
+func ieAC(ie string) bool {
+	if len(ie) != 13 || !strings.HasPrefix(ie, "01") {
+		return false
+	}
+	return digitAt(ie, 11) == ieDigit(ie[:11], ieP13a) &&
+		digitAt(ie, 12) == ieDigit(ie[:12], ieP13b)
+}
+
+func ieDF(ie string) bool {
+	if len(ie) != 13 || !strings.HasPrefix(ie, "07") && !strings.HasPrefix(ie, "08") {
+		return false
+	}
+	return digitAt(ie, 11) == ieDigit(ie[:11], ieP13a) &&
+		digitAt(ie, 12) == ieDigit(ie[:12], ieP13b)
+}
+
+func ieAL(ie string) bool {
+	if len(ie) != 9 || !strings.HasPrefix(ie, "24") {
+		return false
+	}
+	if !strings.ContainsRune("03578", rune(ie[2])) {
+		return false
+	}
+	d := sum(ie[:8], ieP2to9) * 10 % 11
+	if d == 10 {
+		d = 0
+	}
+	return digitAt(ie, 8) == d
+}
+
+func ieAM(ie string) bool {
+	if len(ie) != 9 {
+		return false
+	}
+	s := sum(ie[:8], ieP2to9)
+	d := 0
+	if s < 11 {
+		d = 11 - s
+	} else if r := s % 11; r > 1 {
+		d = 11 - r
+	}
+	return digitAt(ie, 8) == d
+}
+
+func ieAP(ie string) bool {
+	if len(ie) != 9 || !strings.HasPrefix(ie, "03") {
+		return false
+	}
+
+	p, d := 0, 0
+	switch base := ie[:8]; {
+	case base <= "03017000":
+		p, d = 5, 0
+	case base <= "03019022":
+		p, d = 9, 1
+	}
+
+	dv := 11 - (p+sum(ie[:8], ieP2to9))%11
+	switch dv {
+	case 10:
+		dv = 0
+	case 11:
+		dv = d
+	}
+	return digitAt(ie, 8) == dv
+}
+
+func ieBA(ie string) bool {
+	if len(ie) != 8 && len(ie) != 9 {
+		return false
+	}
+
+	n := len(ie) - 2
+	t := ie[0]
+	if len(ie) == 9 {
+		t = ie[1]
+	}
+
+	mod := 10
+	if t == '6' || t == '7' || t == '9' {
+		mod = 11
+	}
+
+	digit := func(s string) int {
+		w := make([]int, len(s))
+		for i := range w {
+			w[i] = len(s) + 1 - i
+		}
+		r := sum(s, w) % mod
+		if mod == 10 {
+			return (10 - r) % 10
+		}
+		if r < 2 {
+			return 0
+		}
+		return 11 - r
+	}
+
+	// the second check digit is computed first and is part of the first.
+	d2 := digit(ie[:n])
+	d1 := digit(ie[:n] + string(byte('0'+d2)))
+	return digitAt(ie, n) == d1 && digitAt(ie, n+1) == d2
+}
+
+func ieGO(ie string) bool {
+	if len(ie) != 9 {
+		return false
+	}
+	prefix := ie[:2]
+	if prefix != "10" && prefix != "11" && prefix != "15" && (prefix < "20" || prefix > "29") {
+		return false
+	}
+
+	base := ie[:8]
+	if base == "11094402" {
+		return ie[8] == '0' || ie[8] == '1'
+	}
+
+	d := 0
+	switch r := sum(base, ieP2to9) % 11; r {
+	case 0:
+	case 1:
+		if base >= "10103105" && base <= "10119997" {
+			d = 1
+		}
+	default:
+		d = 11 - r
+	}
+	return digitAt(ie, 8) == d
+}
+
+func ieMG(ie string) bool {
+	if len(ie) != 13 {
+		return false
+	}
+
+	// the first digit uses the municipality code followed by a zero and
+	// sums the digits of the products.
+	s := 0
+	for i, c := range ie[:3] + "0" + ie[3:11] {
+		p := int(c-'0') * (1 + i%2)
+		s += p/10 + p%10
+	}
+	d1 := (10 - s%10) % 10
+
+	d2 := ieDigit(ie[:11]+string(byte('0'+d1)), []int{3, 2, 11, 10, 9, 8, 7, 6, 5, 4, 3, 2})
+	return digitAt(ie, 11) == d1 && digitAt(ie, 12) == d2
+}
+
+func ieMS(ie string) bool {
+	if len(ie) != 9 || !strings.HasPrefix(ie, "28") && !strings.HasPrefix(ie, "50") {
+		return false
+	}
+	d := 0
+	if r := sum(ie[:8], ieP2to9) % 11; r > 0 && 11-r <= 9 {
+		d = 11 - r
+	}
+	return digitAt(ie, 8) == d
+}
+
+func ieMT(ie string) bool {
+	if len(ie) > 11 {
+		return false
+	}
+	ie = strings.Repeat("0", 11-len(ie)) + ie
+	return digitAt(ie, 10) == ieDigit(ie[:10], []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2})
+}
+
+func iePE(ie string) bool {
+	switch len(ie) {
+	case 9:
+		d1 := ieDigit(ie[:7], ieP2to9[1:])
+		d2 := ieDigit(ie[:8], ieP2to9)
+		return digitAt(ie, 7) == d1 && digitAt(ie, 8) == d2
+	case 14:
+		// legacy CACEPE registration.
+		d := 11 - sum(ie[:13], []int{5, 4, 3, 2, 1, 9, 8, 7, 6, 5, 4, 3, 2})%11
+		if d > 9 {
+			d -= 10
+		}
+		return digitAt(ie, 13) == d
+	}
+	return false
+}
+
+func iePR(ie string) bool {
+	if len(ie) != 10 {
+		return false
+	}
+	return digitAt(ie, 8) == ieDigit(ie[:8], []int{3, 2, 7, 6, 5, 4, 3, 2}) &&
+		digitAt(ie, 9) == ieDigit(ie[:9], []int{4, 3, 2, 7, 6, 5, 4, 3, 2})
+}
+
+func ieRJ(ie string) bool {
+	if len(ie) != 8 {
+		return false
+	}
+	return digitAt(ie, 7) == ieDigit(ie[:7], []int{2, 7, 6, 5, 4, 3, 2})
+}
+
+func ieRN(ie string) bool {
+	if len(ie) != 9 && len(ie) != 10 || !strings.HasPrefix(ie, "20") {
+		return false
+	}
+	n := len(ie) - 1
+	d := sum(ie[:n], ieP2to10[9-n:]) * 10 % 11
+	if d == 10 {
+		d = 0
+	}
+	return digitAt(ie, n) == d
+}
+
+func ieRO(ie string) bool {
+	var d int
+	switch len(ie) {
+	case 9:
+		// registrations issued before 2000, with the municipality code
+		// in the first three digits.
+		d = 11 - sum(ie[3:8], []int{6, 5, 4, 3, 2})%11
+	case 14:
+		d = 11 - sum(ie[:13], []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2})%11
+	default:
+		return false
+	}
+	if d >= 10 {
+		d -= 10
+	}
+	return digitAt(ie, len(ie)-1) == d
+}
+
+func ieRR(ie string) bool {
+	if len(ie) != 9 || !strings.HasPrefix(ie, "24") {
+		return false
+	}
+	return digitAt(ie, 8) == sum(ie[:8], []int{1, 2, 3, 4, 5, 6, 7, 8})%9
+}
+
+func ieRS(ie string) bool {
+	if len(ie) != 10 {
+		return false
+	}
+	d := 11 - sum(ie[:9], []int{2, 9, 8, 7, 6, 5, 4, 3, 2})%11
+	if d >= 10 {
+		d = 0
+	}
+	return digitAt(ie, 9) == d
+}
+
+func ieSP(ie string) bool {
+	p1 := []int{1, 3, 4, 5, 6, 7, 8, 10}
+
+	// rural producer: P followed by 8 digits, check digit and 3 digits.
+	if strings.HasPrefix(ie, "P") {
+		ie = ie[1:]
+		if len(ie) != 12 {
+			return false
+		}
+		return digitAt(ie, 8) == sum(ie[:8], p1)%11%10
+	}
+
+	if len(ie) != 12 {
+		return false
+	}
+	return digitAt(ie, 8) == sum(ie[:8], p1)%11%10 &&
+		digitAt(ie, 11) == sum(ie[:11], []int{3, 2, 10, 9, 8, 7, 6, 5, 4, 3, 2})%11%10
+}
+
+func ieTO(ie string) bool {
+	switch len(ie) {
+	case 9:
+		return ieMod11(ie)
+	case 11:
+		// legacy format with the company type in the third and fourth
+		// digits, which are not part of the check digit.
+		switch ie[2:4] {
+		case "01", "02", "03", "99":
+		default:
+			return false
+		}
+		return ieMod11(ie[:2] + ie[4:])
+	}
+	return false
+}
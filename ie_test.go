@@ -0,0 +1,104 @@
+package validatebr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleInscricaoEstadual demonstrates how to validate a state registration.
+func ExampleInscricaoEstadual() {
+	inputs := []struct{ ie, uf string }{
+		{"110.042.490.114", "SP"},
+		{"P-01100424.3/002", "SP"},
+		{"ISENTO", "RJ"},
+		{"110.042.490.115", "SP"}, // digit mismatch
+	}
+
+	for _, in := range inputs {
+		fmt.Printf("%s/%s -> %v\n", in.ie, in.uf, validatebr.InscricaoEstadual(in.ie, in.uf))
+	}
+
+	// Output:
+	// 110.042.490.114/SP -> true
+	// P-01100424.3/002/SP -> true
+	// ISENTO/RJ -> true
+	// 110.042.490.115/SP -> false
+}
+
+func TestInscricaoEstadual(t *testing.T) {
+	tests := []struct {
+		uf      string
+		valid   []string
+		invalid []string
+	}{
+		{uf: "AC", valid: []string{"01.004.823/001-12"}, invalid: []string{"01.004.823/001-13", "02.004.823/001-12"}},
+		{uf: "AL", valid: []string{"240000048"}, invalid: []string{"240000049", "241000048"}},
+		{uf: "AM", valid: []string{"04.155.004-8", "000000019"}, invalid: []string{"04.155.004-0"}},
+		{uf: "AP", valid: []string{"030123459"}, invalid: []string{"030123458", "040123459"}},
+		{uf: "BA", valid: []string{"123456-63", "612345-57", "1000003-06"}, invalid: []string{"123456-64", "612345-58", "1000003-07"}},
+		{uf: "CE", valid: []string{"06000001-5"}, invalid: []string{"06000001-6"}},
+		{uf: "DF", valid: []string{"07300001001-09"}, invalid: []string{"07300001001-08"}},
+		{uf: "ES", valid: []string{"999999990"}, invalid: []string{"999999991"}},
+		{uf: "GO", valid: []string{"10.987.654-7", "11094402-0", "11094402-1"}, invalid: []string{"10.987.654-8", "30.987.654-7"}},
+		{uf: "MA", valid: []string{"120000385"}, invalid: []string{"120000386", "130000385"}},
+		{uf: "MG", valid: []string{"062.307.904/0081"}, invalid: []string{"062.307.904/0082", "062.307.904/0091"}},
+		{uf: "MS", valid: []string{"280000006"}, invalid: []string{"280000007", "290000006"}},
+		{uf: "MT", valid: []string{"0013000001-9", "13000001-9"}, invalid: []string{"0013000001-8"}},
+		{uf: "PA", valid: []string{"15-999999-5"}, invalid: []string{"15-999999-6", "16-999999-5"}},
+		{uf: "PB", valid: []string{"06000001-5"}, invalid: []string{"06000001-4"}},
+		{uf: "PE", valid: []string{"0321418-40", "18.1.001.0000004-9"}, invalid: []string{"0321418-41", "18.1.001.0000004-8"}},
+		{uf: "PI", valid: []string{"012345679"}, invalid: []string{"012345678"}},
+		{uf: "PR", valid: []string{"123.45678-50"}, invalid: []string{"123.45678-51"}},
+		{uf: "RJ", valid: []string{"99.999.99-3"}, invalid: []string{"99.999.99-4"}},
+		{uf: "RN", valid: []string{"20.040.040-1", "20.0.040.040-0"}, invalid: []string{"20.040.040-2", "21.040.040-1"}},
+		{uf: "RO", valid: []string{"101.62521-3", "0000000062521-3"}, invalid: []string{"101.62521-4", "0000000062521-4"}},
+		{uf: "RR", valid: []string{"24006628-1"}, invalid: []string{"24006628-2", "25006628-1"}},
+		{uf: "RS", valid: []string{"224/3658792"}, invalid: []string{"224/3658793"}},
+		{uf: "SC", valid: []string{"251.040.852"}, invalid: []string{"251.040.853"}},
+		{uf: "SE", valid: []string{"27123456-3"}, invalid: []string{"27123456-4"}},
+		{uf: "SP", valid: []string{"110.042.490.114", "P-01100424.3/002"}, invalid: []string{"110.042.490.115", "P-01100424.4/002", "0P-1100424.3/002"}},
+		{uf: "TO", valid: []string{"29.01.022783-6", "29022783-6"}, invalid: []string{"29.04.022783-6", "29022783-7"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.uf, func(t *testing.T) {
+			for _, ie := range tt.valid {
+				if !validatebr.InscricaoEstadual(ie, tt.uf) {
+					t.Errorf("InscricaoEstadual(%q, %q) = false; want true", ie, tt.uf)
+				}
+			}
+			for _, ie := range tt.invalid {
+				if validatebr.InscricaoEstadual(ie, tt.uf) {
+					t.Errorf("InscricaoEstadual(%q, %q) = true; want false", ie, tt.uf)
+				}
+			}
+			if !validatebr.InscricaoEstadual("isento", tt.uf) {
+				t.Errorf("InscricaoEstadual(%q, %q) = false; want true", "isento", tt.uf)
+			}
+		})
+	}
+}
+
+func TestInscricaoEstadualInvalidInput(t *testing.T) {
+	tests := []struct {
+		name string
+		ie   string
+		uf   string
+	}{
+		{name: "Unknown UF", ie: "110.042.490.114", uf: "XX"},
+		{name: "Empty string", ie: "", uf: "SP"},
+		{name: "All repetitive digits", ie: "111111111", uf: "SC"},
+		{name: "Producer prefix outside SP", ie: "P-01100424.3/002", uf: "RJ"},
+		{name: "Letters", ie: "11O.042.490.114", uf: "SP"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if validatebr.InscricaoEstadual(tt.ie, tt.uf) {
+				t.Errorf("InscricaoEstadual(%q, %q) = true; want false", tt.ie, tt.uf)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package validatebr
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	ErrUnsupportedMunicipality = errors.New("unsupported municipality")
+
+	imMu sync.RWMutex
+
+	// imRules maps IBGE municipality codes to the validation of their
+	// inscrição municipal. The only built-in rule is the São Paulo CCM,
+	// an 8-digit number as defined by the tpInscricaoMunicipal type of
+	// the NFS-e web service layout of the city; its check digit is not
+	// verified. Other municipalities must be added with
+	// RegisterInscricaoMunicipal.
+	imRules = map[int]func(string) bool{
+		3550308: imDigits(8, 8), // São Paulo (CCM)
+	}
+)
+
+// imDigits accepts numbers with min to max digits that are not all the
+// same digit.
+func imDigits(min, max int) func(string) bool {
+	return func(im string) bool {
+		im = RemoveNonDigits(im)
+		return len(im) >= min && len(im) <= max && !IsRepetitive(im)
+	}
+}
+
+// RegisterInscricaoMunicipal adds or replaces the inscrição municipal rule
+// for the municipality with the given IBGE code.
+func RegisterInscricaoMunicipal(ibge int, rule func(im string) bool) {
+	imMu.Lock()
+	defer imMu.Unlock()
+	imRules[ibge] = rule
+}
+
+// InscricaoMunicipal validates an inscrição municipal for the municipality
+// with the given IBGE code. It returns ErrUnsupportedMunicipality when
+// there is no rule for the municipality.
+func InscricaoMunicipal(im string, ibge int) (bool, error) {
+	imMu.RLock()
+	rule, ok := imRules[ibge]
+	imMu.RUnlock()
+	if !ok {
+		return false, ErrUnsupportedMunicipality
+	}
+	return rule(im), nil
+}
@@ -0,0 +1,76 @@
+package validatebr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleRegisterInscricaoMunicipal demonstrates how to add a rule for a municipality.
+func ExampleRegisterInscricaoMunicipal() {
+	// Campinas, with a hypothetical 9-digit rule.
+	validatebr.RegisterInscricaoMunicipal(3509502, func(im string) bool {
+		return len(validatebr.RemoveNonDigits(im)) == 9
+	})
+
+	fmt.Println(validatebr.InscricaoMunicipal("123.456.789", 3509502))
+	fmt.Println(validatebr.InscricaoMunicipal("123.456.789", 9999999))
+
+	// Output:
+	// true <nil>
+	// false unsupported municipality
+}
+
+func TestInscricaoMunicipal(t *testing.T) {
+	tests := []struct {
+		name     string
+		im       string
+		ibge     int
+		expected bool
+		err      error
+	}{
+		{
+			name:     "Valid São Paulo CCM",
+			im:       "1.234.567-8",
+			ibge:     3550308,
+			expected: true,
+		},
+		{
+			name:     "Invalid São Paulo CCM length",
+			im:       "1.234.567",
+			ibge:     3550308,
+			expected: false,
+		},
+		{
+			name:     "Municipality without a built-in rule",
+			im:       "0.123.456-7",
+			ibge:     3304557,
+			expected: false,
+			err:      validatebr.ErrUnsupportedMunicipality,
+		},
+		{
+			name:     "Repetitive digits",
+			im:       "11111111",
+			ibge:     3550308,
+			expected: false,
+		},
+		{
+			name:     "Unsupported municipality",
+			im:       "12345678",
+			ibge:     1100205,
+			expected: false,
+			err:      validatebr.ErrUnsupportedMunicipality,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validatebr.InscricaoMunicipal(tt.im, tt.ibge)
+			if result != tt.expected || !errors.Is(err, tt.err) {
+				t.Errorf("InscricaoMunicipal(%q, %d) = %v, %v; want %v, %v", tt.im, tt.ibge, result, err, tt.expected, tt.err)
+			}
+		})
+	}
+}
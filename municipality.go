@@ -0,0 +1,95 @@
+package validatebr
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+var (
+	// codes assigned by IBGE whose last digit does not match the
+	// check digit algorithm.
+	municipalityExceptions = map[string]bool{
+		"2201919": true, // Bom Princípio do Piauí
+		"2201988": true, // Brejo do Piauí
+		"2202251": true, // Canavieira
+		"2611533": true, // Quixaba
+		"3117836": true, // Cônego Marinho
+		"3152131": true, // Ponto Chique
+		"4305871": true, // Coronel Barros
+		"5203939": true, // Buriti de Goiás
+		"5203962": true, // Buritinópolis
+	}
+
+	accentReplacer = strings.NewReplacer(
+		"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a",
+		"é", "e", "è", "e", "ê", "e", "ë", "e",
+		"í", "i", "ì", "i", "î", "i", "ï", "i",
+		"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o",
+		"ú", "u", "ù", "u", "û", "u", "ü", "u",
+		"ç", "c", "ñ", "n",
+	)
+)
+
+//go:generate go run ./processMunicipalityTable -o municipality_table.go
+
+// Municipality is a Brazilian municipality identified by its 7-digit IBGE
+// code.
+type Municipality struct {
+	Code int
+	Name string
+	UF   UF
+}
+
+// foldAccents lowercases s and removes the diacritics used in Portuguese.
+func foldAccents(s string) string {
+	return accentReplacer.Replace(strings.ToLower(s))
+}
+
+// IBGEMunicipality validates the check digit of a 7-digit IBGE
+// municipality code, accepting the known exceptions.
+func IBGEMunicipality(code string) bool {
+	code = RemoveNonDigits(code)
+	if len(code) != 7 {
+		return false
+	}
+
+	uf, _ := strconv.Atoi(code[:2])
+	if !UF(uf).Valid() {
+		return false
+	}
+
+	if municipalityExceptions[code] {
+		return true
+	}
+
+	return digitAt(code, 6) == mod10Digit(code[:6])
+}
+
+// MunicipalityByCode looks up a municipality in the embedded table.
+func MunicipalityByCode(code int) (Municipality, bool) {
+	i, ok := slices.BinarySearchFunc(municipalities, code, func(m Municipality, code int) int {
+		return m.Code - code
+	})
+	if !ok {
+		return Municipality{}, false
+	}
+	return municipalities[i], true
+}
+
+// SearchMunicipalities returns the municipalities whose name contains
+// name, ignoring case and accents.
+func SearchMunicipalities(name string) []Municipality {
+	name = foldAccents(strings.TrimSpace(name))
+	if name == "" {
+		return nil
+	}
+
+	var ret []Municipality
+	for _, m := range municipalities {
+		if strings.Contains(foldAccents(m.Name), name) {
+			ret = append(ret, m)
+		}
+	}
+	return ret
+}
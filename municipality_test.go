@@ -0,0 +1,105 @@
+package validatebr_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleSearchMunicipalities demonstrates how to search municipalities ignoring accents.
+func ExampleSearchMunicipalities() {
+	for _, m := range validatebr.SearchMunicipalities("sao jose dos campos") {
+		fmt.Println(m.Code, m.Name, m.UF)
+	}
+
+	// Output:
+	// 3549904 São José dos Campos SP
+}
+
+func TestIBGEMunicipality(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{name: "São Paulo", input: "3550308", expected: true},
+		{name: "Rio de Janeiro with mask", input: "33.04557", expected: true},
+		{name: "Exception Canavieira", input: "2202251", expected: true},
+		{name: "Exception Buritinópolis", input: "5203962", expected: true},
+		{name: "Invalid check digit", input: "3550309", expected: false},
+		{name: "Invalid UF code", input: "3450308", expected: false},
+		{name: "Invalid length", input: "355030", expected: false},
+		{name: "Empty string", input: "", expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.IBGEMunicipality(tt.input)
+			if result != tt.expected {
+				t.Errorf("IBGEMunicipality(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMunicipalityByCode(t *testing.T) {
+	m, ok := validatebr.MunicipalityByCode(4106902)
+	if !ok || m.Name != "Curitiba" || m.UF != validatebr.PR {
+		t.Errorf("MunicipalityByCode(4106902) = %+v, %v; want Curitiba/PR", m, ok)
+	}
+
+	m, ok = validatebr.MunicipalityByCode(3501608)
+	if !ok || m.Name != "Americana" || m.UF != validatebr.SP {
+		t.Errorf("MunicipalityByCode(3501608) = %+v, %v; want Americana/SP", m, ok)
+	}
+
+	if _, ok := validatebr.MunicipalityByCode(4106903); ok {
+		t.Error("MunicipalityByCode(4106903) found; want not found")
+	}
+}
+
+func TestSearchMunicipalities(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		contains []int
+	}{
+		{name: "Without accents", input: "goiania", contains: []int{5208707}},
+		{name: "With accents and uppercase", input: "BELÉM", contains: []int{1501402}},
+		{name: "Partial name", input: "piaui", contains: []int{2201919, 2201988}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.SearchMunicipalities(tt.input)
+			codes := map[int]bool{}
+			for _, m := range result {
+				codes[m.Code] = true
+				if !strings.Contains(foldAccents(m.Name), foldAccents(tt.input)) {
+					t.Errorf("SearchMunicipalities(%q) returned %q", tt.input, m.Name)
+				}
+			}
+			for _, c := range tt.contains {
+				if !codes[c] {
+					t.Errorf("SearchMunicipalities(%q) does not contain %d", tt.input, c)
+				}
+			}
+		})
+	}
+
+	for _, input := range []string{"Atlantis", " "} {
+		if result := validatebr.SearchMunicipalities(input); result != nil {
+			t.Errorf("SearchMunicipalities(%q) = %v; want nil", input, result)
+		}
+	}
+}
+
+// foldAccents mirrors the folding used by SearchMunicipalities.
+func foldAccents(s string) string {
+	return strings.NewReplacer(
+		"á", "a", "â", "a", "ã", "a", "é", "e", "ê", "e", "í", "i",
+		"ó", "o", "ô", "o", "õ", "o", "ú", "u", "ü", "u", "ç", "c",
+	).Replace(strings.ToLower(s))
+}
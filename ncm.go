@@ -0,0 +1,133 @@
+package validatebr
+
+//go:generate go run ./processNCMTable -o ncm_table.go
+//go:generate go run ./processCESTTable -o cest_table.go
+
+import (
+	"errors"
+	"slices"
+	"strings"
+)
+
+var ErrUnknownCEST = errors.New("unknown cest")
+
+// CESTItem is an entry of the CEST table of Convênio ICMS 142/18, with
+// the NCM prefixes it applies to.
+type CESTItem struct {
+	Code        string
+	Description string
+	NCM         []string
+}
+
+// IsNCM checks the format of an 8-digit NCM code and its chapter in the
+// Mercosul nomenclature (01 to 97, chapter 77 being reserved). It does not
+// check that the code exists in the TIPI.
+func IsNCM(ncm string) bool {
+	ncm = RemoveNonDigits(ncm)
+	if len(ncm) != 8 {
+		return false
+	}
+
+	chapter := ncm[:2]
+	return chapter >= "01" && chapter <= "97" && chapter != "77"
+}
+
+// NCM validates an 8-digit NCM code against the embedded table of the
+// nomenclature in force.
+func NCM(ncm string) bool {
+	ncm = RemoveNonDigits(ncm)
+	if len(ncm) != 8 {
+		return false
+	}
+	_, ok := ncmDescriptions[ncm]
+	return ok
+}
+
+// FormatNCM formats an 8-digit NCM code as 0000.00.00.
+func FormatNCM(ncm string) (string, error) {
+	ncm = RemoveNonDigits(ncm)
+	if len(ncm) != 8 {
+		return "", ErrInvalidLength
+	}
+	return applyMask(ncm, "####.##.##"), nil
+}
+
+// NCMDescription returns the description of the most specific level of
+// ncm found in the embedded table: item, subheading, heading or chapter.
+func NCMDescription(ncm string) (string, bool) {
+	if !IsNCM(ncm) {
+		return "", false
+	}
+	ncm = RemoveNonDigits(ncm)
+
+	for _, n := range []int{8, 7, 6, 5, 4, 2} {
+		if d, ok := ncmDescriptions[ncm[:n]]; ok {
+			return d, true
+		}
+	}
+	return "", false
+}
+
+// IsCEST checks the format of a 7-digit CEST code: segment (01 to 28),
+// item and specification. It does not check that the code exists in
+// Convênio ICMS 142/18.
+func IsCEST(cest string) bool {
+	cest = RemoveNonDigits(cest)
+	if len(cest) != 7 {
+		return false
+	}
+
+	segment := cest[:2]
+	return segment >= "01" && segment <= "28" && cest[2:5] != "000"
+}
+
+// CEST validates a CEST code against the embedded table of Convênio ICMS
+// 142/18.
+func CEST(cest string) bool {
+	_, ok := CESTByCode(cest)
+	return ok
+}
+
+// FormatCEST formats a 7-digit CEST code as 00.000.00.
+func FormatCEST(cest string) (string, error) {
+	cest = RemoveNonDigits(cest)
+	if len(cest) != 7 {
+		return "", ErrInvalidLength
+	}
+	return applyMask(cest, "##.###.##"), nil
+}
+
+// CESTByCode looks up a CEST code in the embedded table of Convênio ICMS
+// 142/18.
+func CESTByCode(cest string) (CESTItem, bool) {
+	cest = RemoveNonDigits(cest)
+	i, ok := slices.BinarySearchFunc(cestItems, cest, func(c CESTItem, cest string) int {
+		return strings.Compare(c.Code, cest)
+	})
+	if !ok {
+		return CESTItem{}, false
+	}
+	return cestItems[i], true
+}
+
+// CESTMatchesNCM reports whether the CEST code applies to the NCM code.
+// ErrUnknownCEST is returned when the CEST is not in the embedded table,
+// in which case the match cannot be decided.
+func CESTMatchesNCM(cest, ncm string) (bool, error) {
+	item, ok := CESTByCode(cest)
+	if !ok {
+		return false, ErrUnknownCEST
+	}
+
+	if !IsNCM(ncm) {
+		return false, nil
+	}
+	ncm = RemoveNonDigits(ncm)
+
+	for _, prefix := range item.NCM {
+		if strings.HasPrefix(ncm, prefix) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,179 @@
+package validatebr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleCESTMatchesNCM demonstrates how to check that a CEST applies to an NCM.
+func ExampleCESTMatchesNCM() {
+	ok, err := validatebr.CESTMatchesNCM("03.021.00", "2203.00.00")
+	fmt.Println(ok, err)
+
+	ok, err = validatebr.CESTMatchesNCM("03.021.00", "2201.10.00")
+	fmt.Println(ok, err)
+
+	// Output:
+	// true <nil>
+	// false <nil>
+}
+
+func TestIsNCM(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "Valid NCM",
+			input:    "22030000",
+			expected: true,
+		},
+		{
+			name:     "Valid formatted NCM",
+			input:    "8517.13.00",
+			expected: true,
+		},
+		{
+			name:     "Reserved chapter 77",
+			input:    "77010000",
+			expected: false,
+		},
+		{
+			name:     "Chapter out of range",
+			input:    "98010000",
+			expected: false,
+		},
+		{
+			name:     "Chapter 00",
+			input:    "00010000",
+			expected: false,
+		},
+		{
+			name:     "Invalid length",
+			input:    "220300",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.IsNCM(tt.input)
+			if result != tt.expected {
+				t.Errorf("IsNCM(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNCM(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{input: "2203.00.00", expected: true},
+		{input: "24022000", expected: true},
+		{input: "2203.00.10", expected: false},
+		{input: "22030000000", expected: false},
+		{input: "2203", expected: false},
+	}
+
+	for _, tt := range tests {
+		if result := validatebr.NCM(tt.input); result != tt.expected {
+			t.Errorf("NCM(%q) = %v; want %v", tt.input, result, tt.expected)
+		}
+	}
+}
+
+func TestNCMDescription(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+		found    bool
+	}{
+		{input: "2203.00.00", expected: "Cervejas de malte", found: true},
+		{input: "2105.00.10", expected: "Sorvetes, mesmo que contenham cacau", found: true},
+		{input: "2208.30.20", expected: "Bebidas, líquidos alcoólicos e vinagres", found: true},
+		{input: "0101.21.00", found: false},
+		{input: "7701.00.00", found: false},
+	}
+
+	for _, tt := range tests {
+		d, ok := validatebr.NCMDescription(tt.input)
+		if d != tt.expected || ok != tt.found {
+			t.Errorf("NCMDescription(%q) = %q, %v; want %q, %v", tt.input, d, ok, tt.expected, tt.found)
+		}
+	}
+}
+
+func TestIsCEST(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{input: "03.021.00", expected: true},
+		{input: "2800100", expected: true},
+		{input: "2900100", expected: false},
+		{input: "0000100", expected: false},
+		{input: "0300000", expected: false},
+		{input: "030210", expected: false},
+	}
+
+	for _, tt := range tests {
+		if result := validatebr.IsCEST(tt.input); result != tt.expected {
+			t.Errorf("IsCEST(%q) = %v; want %v", tt.input, result, tt.expected)
+		}
+	}
+}
+
+func TestCEST(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected bool
+	}{
+		{input: "03.021.00", expected: true},
+		{input: "2300100", expected: true},
+		{input: "2899900", expected: false},
+		{input: "030210", expected: false},
+	}
+
+	for _, tt := range tests {
+		if result := validatebr.CEST(tt.input); result != tt.expected {
+			t.Errorf("CEST(%q) = %v; want %v", tt.input, result, tt.expected)
+		}
+	}
+}
+
+func TestCESTMatchesNCM(t *testing.T) {
+	tests := []struct {
+		cest     string
+		ncm      string
+		expected bool
+		err      error
+	}{
+		{cest: "0302200", ncm: "22029100", expected: true},
+		{cest: "0302200", ncm: "22029900", expected: false},
+		{cest: "0400100", ncm: "24022000", expected: true},
+		{cest: "0500100", ncm: "25232910", expected: true},
+		{cest: "0500100", ncm: "123", expected: false},
+		{cest: "2899900", ncm: "22030000", err: validatebr.ErrUnknownCEST},
+	}
+
+	for _, tt := range tests {
+		ok, err := validatebr.CESTMatchesNCM(tt.cest, tt.ncm)
+		if ok != tt.expected || err != tt.err {
+			t.Errorf("CESTMatchesNCM(%q, %q) = %v, %v; want %v, %v", tt.cest, tt.ncm, ok, err, tt.expected, tt.err)
+		}
+	}
+}
+
+func TestFormatNCMAndCEST(t *testing.T) {
+	if ncm, err := validatebr.FormatNCM("22030000"); ncm != "2203.00.00" || err != nil {
+		t.Errorf("FormatNCM() = %q, %v; want %q", ncm, err, "2203.00.00")
+	}
+	if cest, err := validatebr.FormatCEST("0302100"); cest != "03.021.00" || err != nil {
+		t.Errorf("FormatCEST() = %q, %v; want %q", cest, err, "03.021.00")
+	}
+}
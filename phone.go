@@ -84,3 +84,65 @@ func PhoneWithBrazilianAreaCodeMap(phone string) bool { // DDD
 
 	return true
 }
+
+// DDDUF returns the UF of a DDD area code. DDD 61 is shared by the
+// Distrito Federal and nearby municipalities of Goiás and returns DF.
+func DDDUF(ddd int) (UF, bool) {
+	switch {
+	case ddd >= 11 && ddd <= 19:
+		return SP, true
+	case ddd == 21 || ddd == 22 || ddd == 24:
+		return RJ, true
+	case ddd == 27 || ddd == 28:
+		return ES, true
+	case ddd >= 31 && ddd <= 35 || ddd == 37 || ddd == 38:
+		return MG, true
+	case ddd >= 41 && ddd <= 46:
+		return PR, true
+	case ddd >= 47 && ddd <= 49:
+		return SC, true
+	case ddd == 51 || ddd >= 53 && ddd <= 55:
+		return RS, true
+	case ddd == 61:
+		return DF, true
+	case ddd == 62 || ddd == 64:
+		return GO, true
+	case ddd == 63:
+		return TO, true
+	case ddd == 65 || ddd == 66:
+		return MT, true
+	case ddd == 67:
+		return MS, true
+	case ddd == 68:
+		return AC, true
+	case ddd == 69:
+		return RO, true
+	case ddd == 71 || ddd >= 73 && ddd <= 75 || ddd == 77:
+		return BA, true
+	case ddd == 79:
+		return SE, true
+	case ddd == 81 || ddd == 87:
+		return PE, true
+	case ddd == 82:
+		return AL, true
+	case ddd == 83:
+		return PB, true
+	case ddd == 84:
+		return RN, true
+	case ddd == 85 || ddd == 88:
+		return CE, true
+	case ddd == 86 || ddd == 89:
+		return PI, true
+	case ddd == 91 || ddd == 93 || ddd == 94:
+		return PA, true
+	case ddd == 92 || ddd == 97:
+		return AM, true
+	case ddd == 95:
+		return RR, true
+	case ddd == 96:
+		return AP, true
+	case ddd == 98 || ddd == 99:
+		return MA, true
+	}
+	return 0, false
+}
@@ -0,0 +1,45 @@
+package validatebr
+
+var pisP = []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+func pisDigit(base string) int {
+	d := 11 - sum(base, pisP)%11
+	if d >= 10 {
+		return 0
+	}
+	return d
+}
+
+// PIS validates a PIS/PASEP/NIT/NIS number.
+func PIS(pis string) bool {
+	pis = RemoveNonDigits(pis)
+	if len(pis) != 11 {
+		return false
+	}
+
+	if IsRepetitive(pis) {
+		return false
+	}
+
+	return int(pis[10]-'0') == pisDigit(pis[:10])
+}
+
+// FormatPIS formats a PIS/PASEP/NIT/NIS number as 000.00000.00-0.
+func FormatPIS(pis string) (string, error) {
+	pis = RemoveNonDigits(pis)
+	if len(pis) != 11 {
+		return "", ErrInvalidLength
+	}
+	return applyMask(pis, "###.#####.##-#"), nil
+}
+
+// GeneratePIS returns a random valid PIS/PASEP/NIT/NIS number, for tests.
+func GeneratePIS() string {
+	for {
+		base := randomDigits(10)
+		pis := base + string(byte('0'+pisDigit(base)))
+		if !IsRepetitive(pis) {
+			return pis
+		}
+	}
+}
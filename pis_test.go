@@ -0,0 +1,87 @@
+package validatebr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleFormatPIS demonstrates how to format a PIS/PASEP number.
+func ExampleFormatPIS() {
+	pis, err := validatebr.FormatPIS("17033259504")
+	fmt.Println(pis, err)
+
+	// Output:
+	// 170.33259.50-4 <nil>
+}
+
+func TestPIS(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "Valid PIS with mask",
+			input:    "170.33259.50-4",
+			expected: true,
+		},
+		{
+			name:     "Valid PIS without mask",
+			input:    "17033259504",
+			expected: true,
+		},
+		{
+			name:     "Valid PIS with remainder zero",
+			input:    "12345678900",
+			expected: true,
+		},
+		{
+			name:     "Invalid check digit",
+			input:    "170.33259.50-5",
+			expected: false,
+		},
+		{
+			name:     "All repetitive digits",
+			input:    "000.00000.00-0",
+			expected: false,
+		},
+		{
+			name:     "Invalid length",
+			input:    "170.33259.50",
+			expected: false,
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.PIS(tt.input)
+			if result != tt.expected {
+				t.Errorf("PIS(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestFormatPIS(t *testing.T) {
+	_, err := validatebr.FormatPIS("1703325950")
+	if !errors.Is(err, validatebr.ErrInvalidLength) {
+		t.Errorf("FormatPIS with 10 digits error = %v; want %v", err, validatebr.ErrInvalidLength)
+	}
+}
+
+func TestGeneratePIS(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		pis := validatebr.GeneratePIS()
+		if !validatebr.PIS(pis) {
+			t.Fatalf("GeneratePIS() = %q; not a valid PIS", pis)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package validatebr
+
+import (
+	"errors"
+	"regexp"
+	"strings"
+)
+
+type PlateFormat int
+
+const (
+	PlateInvalid PlateFormat = iota
+	PlateLegacy
+	PlateMercosul
+)
+
+var (
+	plateLegacyRegex   = regexp.MustCompile(`^[A-Z]{3}[0-9]{4}$`)
+	plateMercosulRegex = regexp.MustCompile(`^[A-Z]{3}[0-9][A-Z][0-9]{2}$`)
+
+	ErrInvalidPlate = errors.New("invalid plate")
+)
+
+func (f PlateFormat) String() string {
+	switch f {
+	case PlateLegacy:
+		return "LEGACY"
+	case PlateMercosul:
+		return "MERCOSUL"
+	}
+	return "INVALID"
+}
+
+// NormalizePlate uppercases a plate and removes hyphens, spaces and
+// other separators.
+func NormalizePlate(plate string) string {
+	return strings.ToUpper(RemoveNonAlphaNum(plate))
+}
+
+// DetectPlateFormat reports whether a plate uses the legacy (ABC-1234) or
+// the Mercosul (ABC1D23) format.
+func DetectPlateFormat(plate string) PlateFormat {
+	plate = NormalizePlate(plate)
+	switch {
+	case plateLegacyRegex.MatchString(plate):
+		return PlateLegacy
+	case plateMercosulRegex.MatchString(plate):
+		return PlateMercosul
+	}
+	return PlateInvalid
+}
+
+func Plate(plate string) bool {
+	return DetectPlateFormat(plate) != PlateInvalid
+}
+
+// PlateToMercosul converts a legacy plate to its Mercosul equivalent by
+// mapping the second digit to a letter (0 to A, 1 to B, ..., 9 to J).
+// Mercosul plates are returned normalised.
+func PlateToMercosul(plate string) (string, error) {
+	plate = NormalizePlate(plate)
+	switch DetectPlateFormat(plate) {
+	case PlateMercosul:
+		return plate, nil
+	case PlateLegacy:
+		b := []byte(plate)
+		b[4] = 'A' + (b[4] - '0')
+		return string(b), nil
+	}
+	return "", ErrInvalidPlate
+}
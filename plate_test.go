@@ -0,0 +1,113 @@
+package validatebr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExamplePlateToMercosul demonstrates how to convert a legacy plate to the Mercosul format.
+func ExamplePlateToMercosul() {
+	plate, err := validatebr.PlateToMercosul("abc-1234")
+	fmt.Println(plate, err)
+
+	// Output:
+	// ABC1C34 <nil>
+}
+
+func TestDetectPlateFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected validatebr.PlateFormat
+	}{
+		{
+			name:     "Legacy plate with hyphen",
+			input:    "ABC-1234",
+			expected: validatebr.PlateLegacy,
+		},
+		{
+			name:     "Legacy plate in lowercase",
+			input:    "abc1234",
+			expected: validatebr.PlateLegacy,
+		},
+		{
+			name:     "Mercosul plate",
+			input:    "ABC1D23",
+			expected: validatebr.PlateMercosul,
+		},
+		{
+			name:     "Mercosul plate with space",
+			input:    "abc 1d23",
+			expected: validatebr.PlateMercosul,
+		},
+		{
+			name:     "Letter in wrong position",
+			input:    "ABC12D3",
+			expected: validatebr.PlateInvalid,
+		},
+		{
+			name:     "Too short",
+			input:    "AB1234",
+			expected: validatebr.PlateInvalid,
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: validatebr.PlateInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.DetectPlateFormat(tt.input)
+			if result != tt.expected {
+				t.Errorf("DetectPlateFormat(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+			if validatebr.Plate(tt.input) != (tt.expected != validatebr.PlateInvalid) {
+				t.Errorf("Plate(%q) = %v", tt.input, validatebr.Plate(tt.input))
+			}
+		})
+	}
+}
+
+func TestPlateToMercosul(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		err      error
+	}{
+		{
+			name:     "Legacy plate with zero",
+			input:    "XYZ-9087",
+			expected: "XYZ9A87",
+		},
+		{
+			name:     "Legacy plate with nine",
+			input:    "ABC1934",
+			expected: "ABC1J34",
+		},
+		{
+			name:     "Mercosul plate is kept",
+			input:    "abc-1d23",
+			expected: "ABC1D23",
+		},
+		{
+			name:  "Invalid plate",
+			input: "A1C1234",
+			err:   validatebr.ErrInvalidPlate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validatebr.PlateToMercosul(tt.input)
+			if result != tt.expected || !errors.Is(err, tt.err) {
+				t.Errorf("PlateToMercosul(%q) = %q, %v; want %q, %v", tt.input, result, err, tt.expected, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+// processBankTable generates banks_table.go from the BCB list of STR
+// participants (ParticipantesSTRport.csv) and the list of Pix participants
+// (participantes-pix.csv), both checked in next to this file. To update
+// the table, replace them with the current files published by the BCB and
+// run go generate.
+//
+// Every ISPB found in either list gets a row; Pix participants that are
+// not in the STR have no COMPE code.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+)
+
+type bank struct {
+	compe     string
+	ispb      string
+	shortName string
+	name      string
+	pix       bool
+}
+
+func readCSV(path string, comma rune) ([][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comma = comma
+	r.FieldsPerRecord = -1
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%s: empty file", path)
+	}
+
+	// skip the header.
+	return records[1:], nil
+}
+
+func loadBanks(strPath, pixPath string) ([]bank, error) {
+	strRecords, err := readCSV(strPath, ',')
+	if err != nil {
+		return nil, err
+	}
+
+	byISPB := map[string]*bank{}
+	for _, r := range strRecords {
+		if len(r) < 6 {
+			return nil, fmt.Errorf("%s: invalid record %v", strPath, r)
+		}
+
+		b := &bank{
+			ispb:      strings.TrimSpace(r[0]),
+			shortName: strings.TrimSpace(r[1]),
+			compe:     strings.TrimSpace(r[2]),
+			name:      strings.TrimSpace(r[5]),
+		}
+		if b.compe == "n/a" {
+			b.compe = ""
+		}
+		byISPB[b.ispb] = b
+	}
+
+	pixRecords, err := readCSV(pixPath, ';')
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range pixRecords {
+		if len(r) < 3 {
+			return nil, fmt.Errorf("%s: invalid record %v", pixPath, r)
+		}
+
+		ispb := strings.TrimSpace(r[0])
+		b, ok := byISPB[ispb]
+		if !ok {
+			b = &bank{
+				ispb:      ispb,
+				name:      strings.TrimSpace(r[1]),
+				shortName: strings.TrimSpace(r[2]),
+			}
+			byISPB[ispb] = b
+		}
+		b.pix = true
+	}
+
+	banks := make([]bank, 0, len(byISPB))
+	for _, b := range byISPB {
+		banks = append(banks, *b)
+	}
+
+	sort.Slice(banks, func(i, j int) bool {
+		return banks[i].ispb < banks[j].ispb
+	})
+
+	return banks, nil
+}
+
+func generate(w io.Writer, banks []bank) error {
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by processBankTable; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package validatebr")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// banks is sorted by ISPB.")
+	fmt.Fprintln(&b, "var banks = []Bank{")
+	for _, v := range banks {
+		fmt.Fprintf(&b, "{COMPE: %q, ISPB: %q, ShortName: %q, Name: %q, Pix: %v},\n",
+			v.compe, v.ispb, v.shortName, v.name, v.pix)
+	}
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(src)
+	return err
+}
+
+func main() {
+	strPath := flag.String("str", "processBankTable/ParticipantesSTRport.csv", "BCB list of STR participants")
+	pixPath := flag.String("pix", "processBankTable/participantes-pix.csv", "BCB list of Pix participants")
+	out := flag.String("o", "", "output file, stdout when empty")
+	flag.Parse()
+
+	banks, err := loadBanks(*strPath, *pixPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		w, err = os.Create(*out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer w.Close()
+	}
+
+	if err := generate(w, banks); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,165 @@
+// processCESTTable generates cest_table.go from the annexes of Convênio
+// ICMS 142/18, with one CEST per row (CEST, NCM/SH and DESCRIÇÃO columns,
+// as in the published tables), exported as CSV (cest.csv) and checked in
+// next to this file. The NCM/SH column may list several NCM
+// codes or prefixes separated by spaces or line breaks. To update the
+// table, replace the CSV with the annexes of the consolidated Convênio and
+// run go generate.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Convênio columns used by the generator; the others (ITEM) are ignored.
+const (
+	columnCEST        = "CEST"
+	columnNCM         = "NCM/SH"
+	columnDescription = "DESCRIÇÃO"
+)
+
+type cest struct {
+	code        string
+	description string
+	ncm         []string
+}
+
+func digits(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		if unicode.IsDigit(c) {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+func loadCEST(path string, comma rune) ([]cest, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comma = comma
+	r.FieldsPerRecord = -1
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%s: empty file", path)
+	}
+
+	columns := map[string]int{}
+	for i, h := range records[0] {
+		columns[strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))] = i
+	}
+	for _, c := range []string{columnCEST, columnNCM, columnDescription} {
+		if _, ok := columns[c]; !ok {
+			return nil, fmt.Errorf("%s: missing %q column", path, c)
+		}
+	}
+
+	seen := map[string]bool{}
+	var ret []cest
+	for _, rec := range records[1:] {
+		if len(rec) != len(records[0]) {
+			return nil, fmt.Errorf("%s: invalid record %v", path, rec)
+		}
+
+		code := digits(rec[columns[columnCEST]])
+		if len(code) != 7 || seen[code] {
+			return nil, fmt.Errorf("%s: invalid or repeated CEST %q", path, rec[columns[columnCEST]])
+		}
+		seen[code] = true
+
+		var ncm []string
+		for _, n := range strings.Fields(rec[columns[columnNCM]]) {
+			n = digits(n)
+			if len(n) < 2 || len(n) > 8 {
+				return nil, fmt.Errorf("%s: CEST %s: invalid NCM %q", path, code, rec[columns[columnNCM]])
+			}
+			ncm = append(ncm, n)
+		}
+		if len(ncm) == 0 {
+			return nil, fmt.Errorf("%s: CEST %s: no NCM", path, code)
+		}
+
+		ret = append(ret, cest{
+			code:        code,
+			description: strings.Join(strings.Fields(rec[columns[columnDescription]]), " "),
+			ncm:         ncm,
+		})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].code < ret[j].code
+	})
+
+	return ret, nil
+}
+
+func generate(w io.Writer, items []cest) error {
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by processCESTTable; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package validatebr")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// cestItems is sorted by code.")
+	fmt.Fprintln(&b, "var cestItems = []CESTItem{")
+	for _, c := range items {
+		fmt.Fprintf(&b, "{Code: %q, Description: %q, NCM: %#v},\n", c.code, c.description, c.ncm)
+	}
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(src)
+	return err
+}
+
+func main() {
+	path := flag.String("cest", "processCESTTable/cest.csv", "annexes of Convênio ICMS 142/18")
+	comma := flag.String("comma", ";", "field separator of the CEST file")
+	out := flag.String("o", "", "output file, stdout when empty")
+	flag.Parse()
+
+	if len([]rune(*comma)) != 1 {
+		log.Fatalf("invalid separator %q", *comma)
+	}
+
+	items, err := loadCEST(*path, []rune(*comma)[0])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		w, err = os.Create(*out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer w.Close()
+	}
+
+	if err := generate(w, items); err != nil {
+		log.Fatal(err)
+	}
+}
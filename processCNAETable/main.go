@@ -0,0 +1,157 @@
+// processCNAETable generates cnae_table.go from the CONCLA/IBGE detailed
+// structure of the CNAE 2.3 subclasses, exported as CSV with one level per
+// row (CNAE_Subclasses_2_3_Estrutura_Detalhada.csv) and checked in next to
+// this file. To update the table, replace the CSV with the current CONCLA
+// export and run go generate.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// columns holding the division, group, class and subclass codes, and the
+// number of digits of each level. The section column is not used.
+var levels = []struct {
+	column string
+	digits int
+}{
+	{"Divisão", 2},
+	{"Grupo", 3},
+	{"Classe", 5},
+	{"Subclasse", 7},
+}
+
+const columnDescription = "Denominação"
+
+func digits(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		if unicode.IsDigit(c) {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+func loadDescriptions(path string, comma rune) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comma = comma
+	r.FieldsPerRecord = -1
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%s: empty file", path)
+	}
+
+	columns := map[string]int{}
+	for i, h := range records[0] {
+		columns[strings.TrimSpace(strings.TrimPrefix(h, "\ufeff"))] = i
+	}
+	descIdx, ok := columns[columnDescription]
+	if !ok {
+		return nil, fmt.Errorf("%s: missing %q column", path, columnDescription)
+	}
+	for _, l := range levels {
+		if _, ok := columns[l.column]; !ok {
+			return nil, fmt.Errorf("%s: missing %q column", path, l.column)
+		}
+	}
+
+	ret := map[string]string{}
+	for _, rec := range records[1:] {
+		if len(rec) <= descIdx {
+			return nil, fmt.Errorf("%s: invalid record %v", path, rec)
+		}
+
+		for _, l := range levels {
+			code := digits(rec[columns[l.column]])
+			if code == "" {
+				continue
+			}
+			if len(code) != l.digits {
+				return nil, fmt.Errorf("%s: invalid %s %q", path, l.column, rec[columns[l.column]])
+			}
+			ret[code] = strings.TrimSpace(rec[descIdx])
+		}
+	}
+
+	return ret, nil
+}
+
+func generate(w io.Writer, descriptions map[string]string) error {
+	codes := make([]string, 0, len(descriptions))
+	for c := range descriptions {
+		codes = append(codes, c)
+	}
+	sort.Strings(codes)
+
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by processCNAETable; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package validatebr")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// cnaeDescriptions maps the digits of divisions (2), groups (3), classes")
+	fmt.Fprintln(&b, "// (5) and subclasses (7) to their descriptions.")
+	fmt.Fprintln(&b, "var cnaeDescriptions = map[string]string{")
+	for _, c := range codes {
+		fmt.Fprintf(&b, "%q: %q,\n", c, descriptions[c])
+	}
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(src)
+	return err
+}
+
+func main() {
+	path := flag.String("cnae", "processCNAETable/CNAE_Subclasses_2_3_Estrutura_Detalhada.csv", "CONCLA detailed structure of the CNAE 2.3 subclasses")
+	comma := flag.String("comma", ";", "field separator of the CNAE file")
+	out := flag.String("o", "", "output file, stdout when empty")
+	flag.Parse()
+
+	if len([]rune(*comma)) != 1 {
+		log.Fatalf("invalid separator %q", *comma)
+	}
+
+	descriptions, err := loadDescriptions(*path, []rune(*comma)[0])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		w, err = os.Create(*out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer w.Close()
+	}
+
+	if err := generate(w, descriptions); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,154 @@
+// processMunicipalityTable generates municipality_table.go from the IBGE
+// Divisão Territorial Brasileira (DTB) list of municipalities, exported
+// as CSV (RELATORIO_DTB_BRASIL_MUNICIPIO.csv) and checked in next to this
+// file. To update the table, replace the CSV with the current DTB export
+// and run go generate.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DTB columns used by the generator; the others are ignored.
+const (
+	columnCode = "Código Município Completo"
+	columnName = "Nome_Município"
+)
+
+var ufAbbr = map[string]string{
+	"11": "RO", "12": "AC", "13": "AM", "14": "RR", "15": "PA", "16": "AP", "17": "TO",
+	"21": "MA", "22": "PI", "23": "CE", "24": "RN", "25": "PB", "26": "PE", "27": "AL",
+	"28": "SE", "29": "BA", "31": "MG", "32": "ES", "33": "RJ", "35": "SP", "41": "PR",
+	"42": "SC", "43": "RS", "50": "MS", "51": "MT", "52": "GO", "53": "DF",
+}
+
+type municipality struct {
+	code int
+	name string
+	uf   string
+}
+
+func loadMunicipalities(path string, comma rune) ([]municipality, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comma = comma
+	r.FieldsPerRecord = -1
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%s: empty file", path)
+	}
+
+	codeIdx, nameIdx := -1, -1
+	for i, h := range records[0] {
+		switch strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")) {
+		case columnCode:
+			codeIdx = i
+		case columnName:
+			nameIdx = i
+		}
+	}
+	if codeIdx < 0 || nameIdx < 0 {
+		return nil, fmt.Errorf("%s: missing %q or %q column", path, columnCode, columnName)
+	}
+
+	var ret []municipality
+	for _, rec := range records[1:] {
+		if len(rec) <= codeIdx || len(rec) <= nameIdx {
+			return nil, fmt.Errorf("%s: invalid record %v", path, rec)
+		}
+
+		code := strings.TrimSpace(rec[codeIdx])
+		uf, ok := ufAbbr[code[:min(2, len(code))]]
+		if len(code) != 7 || !ok {
+			return nil, fmt.Errorf("%s: invalid code %q", path, code)
+		}
+
+		n, err := strconv.Atoi(code)
+		if err != nil {
+			return nil, fmt.Errorf("%s: invalid code %q", path, code)
+		}
+
+		ret = append(ret, municipality{
+			code: n,
+			name: strings.TrimSpace(rec[nameIdx]),
+			uf:   uf,
+		})
+	}
+
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].code < ret[j].code
+	})
+
+	return ret, nil
+}
+
+func generate(w io.Writer, municipalities []municipality) error {
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by processMunicipalityTable; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package validatebr")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// municipalities is sorted by code.")
+	fmt.Fprintln(&b, "var municipalities = []Municipality{")
+	for _, m := range municipalities {
+		fmt.Fprintf(&b, "{Code: %d, Name: %q, UF: %s},\n", m.code, m.name, m.uf)
+	}
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(src)
+	return err
+}
+
+func main() {
+	path := flag.String("dtb", "processMunicipalityTable/RELATORIO_DTB_BRASIL_MUNICIPIO.csv", "IBGE DTB list of municipalities")
+	comma := flag.String("comma", ";", "field separator of the DTB file")
+	out := flag.String("o", "", "output file, stdout when empty")
+	flag.Parse()
+
+	if len([]rune(*comma)) != 1 {
+		log.Fatalf("invalid separator %q", *comma)
+	}
+
+	municipalities, err := loadMunicipalities(*path, []rune(*comma)[0])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		w, err = os.Create(*out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer w.Close()
+	}
+
+	if err := generate(w, municipalities); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,148 @@
+// processNCMTable generates ncm_table.go from the NCM in force published
+// by Siscomex (the nomenclature used by the TIPI), exported as CSV with one
+// level per row (Tabela_NCM_Vigente.csv) and checked in next to this file.
+// To update the table, replace the CSV with the current Siscomex export
+// and run go generate.
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Siscomex columns used by the generator; the others (dates and legal
+// acts) are ignored.
+const (
+	columnCode        = "Código"
+	columnDescription = "Descrição"
+)
+
+func digits(s string) string {
+	var b strings.Builder
+	for _, c := range s {
+		if unicode.IsDigit(c) {
+			b.WriteRune(c)
+		}
+	}
+	return b.String()
+}
+
+func loadDescriptions(path string, comma rune) (map[string]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r := csv.NewReader(f)
+	r.Comma = comma
+	r.FieldsPerRecord = -1
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("%s: empty file", path)
+	}
+
+	codeIdx, descIdx := -1, -1
+	for i, h := range records[0] {
+		switch strings.TrimSpace(strings.TrimPrefix(h, "\ufeff")) {
+		case columnCode:
+			codeIdx = i
+		case columnDescription:
+			descIdx = i
+		}
+	}
+	if codeIdx < 0 || descIdx < 0 {
+		return nil, fmt.Errorf("%s: missing %q or %q column", path, columnCode, columnDescription)
+	}
+
+	ret := map[string]string{}
+	for _, rec := range records[1:] {
+		if len(rec) <= codeIdx || len(rec) <= descIdx {
+			return nil, fmt.Errorf("%s: invalid record %v", path, rec)
+		}
+
+		code := digits(rec[codeIdx])
+		if len(code) < 2 || len(code) > 8 {
+			return nil, fmt.Errorf("%s: invalid code %q", path, rec[codeIdx])
+		}
+
+		// Siscomex marks the level of subheadings and items with leading
+		// dashes ("-- Outros").
+		ret[code] = strings.TrimSpace(strings.TrimLeft(rec[descIdx], "- –"))
+	}
+
+	return ret, nil
+}
+
+func generate(w io.Writer, descriptions map[string]string) error {
+	codes := make([]string, 0, len(descriptions))
+	for c := range descriptions {
+		codes = append(codes, c)
+	}
+	sort.Strings(codes)
+
+	var b bytes.Buffer
+
+	fmt.Fprintln(&b, "// Code generated by processNCMTable; DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package validatebr")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// ncmDescriptions maps the digits of chapters (2), headings (4),")
+	fmt.Fprintln(&b, "// subheadings (5 and 6) and items (7 and 8) of the NCM to their")
+	fmt.Fprintln(&b, "// descriptions.")
+	fmt.Fprintln(&b, "var ncmDescriptions = map[string]string{")
+	for _, c := range codes {
+		fmt.Fprintf(&b, "%q: %q,\n", c, descriptions[c])
+	}
+	fmt.Fprintln(&b, "}")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(src)
+	return err
+}
+
+func main() {
+	path := flag.String("ncm", "processNCMTable/Tabela_NCM_Vigente.csv", "Siscomex table of the NCM in force")
+	comma := flag.String("comma", ";", "field separator of the NCM file")
+	out := flag.String("o", "", "output file, stdout when empty")
+	flag.Parse()
+
+	if len([]rune(*comma)) != 1 {
+		log.Fatalf("invalid separator %q", *comma)
+	}
+
+	descriptions, err := loadDescriptions(*path, []rune(*comma)[0])
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := os.Stdout
+	if *out != "" {
+		w, err = os.Create(*out)
+		if err != nil {
+			log.Fatal(err)
+		}
+		defer w.Close()
+	}
+
+	if err := generate(w, descriptions); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,134 @@
+package validatebr
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrInvalidQRCode = errors.New("invalid qr code")
+	ErrNoQRCodeHash  = errors.New("qr code has no hash")
+)
+
+// NFCeQRCode holds the parameters of an NFC-e QR code URL. Offline
+// (contingency) QR codes also carry the day of emission and the amount.
+type NFCeQRCode struct {
+	AccessKey   AccessKey
+	Key         string
+	Version     int
+	Environment int // tpAmb: 1 production, 2 homologation
+	Offline     bool
+
+	Day    int    // day of emission, offline only
+	Amount string // vNF, offline only
+
+	// version 2.0 fields.
+	DigestValue string // digVal, offline only
+	TokenID     int    // cIdToken
+	Hash        string // cHashQRCode
+
+	// version 3.0 offline fields.
+	RecipientType int    // tpIdDest
+	Recipient     string // idDest
+	Signature     string
+
+	hashed string
+}
+
+// ParseNFCeQRCode parses the URL of an NFC-e QR code, version 2.0 or 3.0,
+// and validates its access key.
+func ParseNFCeQRCode(rawURL string) (NFCeQRCode, error) {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return NFCeQRCode{}, ErrInvalidQRCode
+	}
+
+	p := u.Query().Get("p")
+	if p == "" {
+		return NFCeQRCode{}, ErrInvalidQRCode
+	}
+
+	fields := strings.Split(p, "|")
+	if len(fields) < 3 {
+		return NFCeQRCode{}, ErrInvalidQRCode
+	}
+
+	q := NFCeQRCode{Key: fields[0]}
+	q.AccessKey, err = ParseAccessKey(fields[0])
+	if err != nil {
+		return NFCeQRCode{}, err
+	}
+	if q.AccessKey.Model != 65 {
+		return NFCeQRCode{}, ErrInvalidQRCode
+	}
+
+	// ints[i] is parsed from fields[i+1]; nil entries are kept as text.
+	ints := []*int{&q.Version, &q.Environment}
+	switch {
+	case fields[1] == "2" && len(fields) == 5:
+		ints = append(ints, &q.TokenID)
+	case fields[1] == "2" && len(fields) == 8:
+		q.Offline = true
+		q.Amount = fields[4]
+		q.DigestValue = fields[5]
+		ints = append(ints, &q.Day, nil, nil, &q.TokenID)
+	case fields[1] == "3" && len(fields) == 3:
+	case fields[1] == "3" && len(fields) == 8:
+		q.Offline = true
+		q.Amount = fields[4]
+		q.Recipient = fields[6]
+		q.Signature = fields[7]
+		ints = append(ints, &q.Day, nil, &q.RecipientType)
+
+		// the recipient is empty for an unidentified consumer.
+		if fields[5] == "" {
+			if fields[6] != "" {
+				return NFCeQRCode{}, ErrInvalidQRCode
+			}
+			ints[len(ints)-1] = nil
+		}
+	default:
+		return NFCeQRCode{}, ErrInvalidQRCode
+	}
+
+	for i, v := range ints {
+		if v == nil {
+			continue
+		}
+		*v, err = strconv.Atoi(fields[i+1])
+		if err != nil {
+			return NFCeQRCode{}, ErrInvalidQRCode
+		}
+	}
+
+	if q.Environment != 1 && q.Environment != 2 {
+		return NFCeQRCode{}, ErrInvalidQRCode
+	}
+
+	if q.Offline && (q.Day < 1 || q.Day > 31) {
+		return NFCeQRCode{}, ErrInvalidQRCode
+	}
+
+	if q.Version == 2 {
+		q.Hash = fields[len(fields)-1]
+		q.hashed = strings.Join(fields[:len(fields)-1], "|")
+	}
+
+	return q, nil
+}
+
+// VerifyHash checks the cHashQRCode of a version 2.0 QR code against the
+// CSC (código de segurança do contribuinte) of the emitter. Version 3.0
+// QR codes have no hash and return ErrNoQRCodeHash.
+func (q NFCeQRCode) VerifyHash(csc string) (bool, error) {
+	if q.Hash == "" {
+		return false, ErrNoQRCodeHash
+	}
+
+	h := sha1.Sum([]byte(q.hashed + csc))
+	return strings.EqualFold(hex.EncodeToString(h[:]), q.Hash), nil
+}
@@ -0,0 +1,170 @@
+package validatebr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+const qrKey = "35241200052998224725650010000000011000000000"
+
+// ExampleParseNFCeQRCode demonstrates how to parse an NFC-e QR code and verify its hash.
+func ExampleParseNFCeQRCode() {
+	q, err := validatebr.ParseNFCeQRCode("https://www.nfce.fazenda.sp.gov.br/qrcode?p=" +
+		qrKey + "|2|2|1|72BB4102A37F0503C79676EAD132681009AB19C1")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(q.Version, q.Environment, q.AccessKey.Emitter)
+	fmt.Println(q.VerifyHash("0123456789"))
+
+	// Output:
+	// 2 2 52998224725
+	// true <nil>
+}
+
+func TestParseNFCeQRCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected validatebr.NFCeQRCode
+		err      error
+	}{
+		{
+			name:     "Version 2.0 online",
+			input:    "https://www.nfce.fazenda.sp.gov.br/qrcode?p=" + qrKey + "%7C2%7C2%7C1%7C72BB4102A37F0503C79676EAD132681009AB19C1",
+			expected: validatebr.NFCeQRCode{Key: qrKey, Version: 2, Environment: 2, TokenID: 1, Hash: "72BB4102A37F0503C79676EAD132681009AB19C1"},
+		},
+		{
+			name:  "Version 2.0 offline",
+			input: "https://www.nfce.fazenda.sp.gov.br/qrcode?p=" + qrKey + "|2|2|15|10.00|6b5a4f3e2d|1|758D2E1E4F9D89088B37F3B6DC482A40DE993F40",
+			expected: validatebr.NFCeQRCode{
+				Key: qrKey, Version: 2, Environment: 2, Offline: true, Day: 15, Amount: "10.00",
+				DigestValue: "6b5a4f3e2d", TokenID: 1, Hash: "758D2E1E4F9D89088B37F3B6DC482A40DE993F40",
+			},
+		},
+		{
+			name:     "Version 3.0 online",
+			input:    "https://www.nfce.fazenda.sp.gov.br/qrcode?p=" + qrKey + "|3|1",
+			expected: validatebr.NFCeQRCode{Key: qrKey, Version: 3, Environment: 1},
+		},
+		{
+			name:  "Version 3.0 offline",
+			input: "https://www.nfce.fazenda.sp.gov.br/qrcode?p=" + qrKey + "|3|1|15|10.00|1|52998224725|c2lnbmF0dXJl",
+			expected: validatebr.NFCeQRCode{
+				Key: qrKey, Version: 3, Environment: 1, Offline: true, Day: 15, Amount: "10.00",
+				RecipientType: 1, Recipient: "52998224725", Signature: "c2lnbmF0dXJl",
+			},
+		},
+		{
+			name:  "Version 3.0 offline without recipient",
+			input: "https://www.nfce.fazenda.sp.gov.br/qrcode?p=" + qrKey + "|3|1|15|10.00|||c2lnbmF0dXJl",
+			expected: validatebr.NFCeQRCode{
+				Key: qrKey, Version: 3, Environment: 1, Offline: true, Day: 15, Amount: "10.00",
+				Signature: "c2lnbmF0dXJl",
+			},
+		},
+		{
+			name:  "Version 3.0 offline with recipient but no type",
+			input: "https://www.nfce.fazenda.sp.gov.br/qrcode?p=" + qrKey + "|3|1|15|10.00||52998224725|c2lnbmF0dXJl",
+			err:   validatebr.ErrInvalidQRCode,
+		},
+		{
+			name:  "Invalid access key",
+			input: "https://www.nfce.fazenda.sp.gov.br/qrcode?p=35241200052998224725650010000000011000000001|3|1",
+			err:   validatebr.ErrInvalidAccessKey,
+		},
+		{
+			name:  "NF-e access key",
+			input: "https://www.nfce.fazenda.sp.gov.br/qrcode?p=35240112345678000195550010000001231123456781|3|1",
+			err:   validatebr.ErrInvalidQRCode,
+		},
+		{
+			name:  "Unknown version",
+			input: "https://www.nfce.fazenda.sp.gov.br/qrcode?p=" + qrKey + "|1|1",
+			err:   validatebr.ErrInvalidQRCode,
+		},
+		{
+			name:  "Invalid environment",
+			input: "https://www.nfce.fazenda.sp.gov.br/qrcode?p=" + qrKey + "|3|3",
+			err:   validatebr.ErrInvalidQRCode,
+		},
+		{
+			name:  "Missing parameter",
+			input: "https://www.nfce.fazenda.sp.gov.br/qrcode",
+			err:   validatebr.ErrInvalidQRCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := validatebr.ParseNFCeQRCode(tt.input)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("ParseNFCeQRCode(%q) error = %v; want %v", tt.input, err, tt.err)
+			}
+			if err != nil {
+				return
+			}
+			result = validatebr.NFCeQRCode{
+				Key: result.Key, Version: result.Version, Environment: result.Environment,
+				Offline: result.Offline, Day: result.Day, Amount: result.Amount,
+				DigestValue: result.DigestValue, TokenID: result.TokenID, Hash: result.Hash,
+				RecipientType: result.RecipientType, Recipient: result.Recipient, Signature: result.Signature,
+			}
+			if result != tt.expected {
+				t.Errorf("ParseNFCeQRCode(%q) = %+v; want %+v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNFCeQRCodeVerifyHash(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		csc      string
+		expected bool
+		err      error
+	}{
+		{
+			name:     "Online hash",
+			input:    "https://www.nfce.fazenda.sp.gov.br/qrcode?p=" + qrKey + "|2|2|1|72bb4102a37f0503c79676ead132681009ab19c1",
+			csc:      "0123456789",
+			expected: true,
+		},
+		{
+			name:     "Offline hash",
+			input:    "https://www.nfce.fazenda.sp.gov.br/qrcode?p=" + qrKey + "|2|2|15|10.00|6b5a4f3e2d|1|758D2E1E4F9D89088B37F3B6DC482A40DE993F40",
+			csc:      "0123456789",
+			expected: true,
+		},
+		{
+			name:     "Wrong CSC",
+			input:    "https://www.nfce.fazenda.sp.gov.br/qrcode?p=" + qrKey + "|2|2|1|72BB4102A37F0503C79676EAD132681009AB19C1",
+			csc:      "9876543210",
+			expected: false,
+		},
+		{
+			name:  "Version 3.0 has no hash",
+			input: "https://www.nfce.fazenda.sp.gov.br/qrcode?p=" + qrKey + "|3|1",
+			csc:   "0123456789",
+			err:   validatebr.ErrNoQRCodeHash,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := validatebr.ParseNFCeQRCode(tt.input)
+			if err != nil {
+				t.Fatalf("ParseNFCeQRCode(%q) error = %v", tt.input, err)
+			}
+			result, err := q.VerifyHash(tt.csc)
+			if result != tt.expected || !errors.Is(err, tt.err) {
+				t.Errorf("VerifyHash(%q) = %v, %v; want %v, %v", tt.csc, result, err, tt.expected, tt.err)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package validatebr
+
+import "strings"
+
+var renavamP = []int{3, 2, 9, 8, 7, 6, 5, 4, 3, 2}
+
+func renavamDigit(base string) int {
+	d := sum(base, renavamP) * 10 % 11
+	if d == 10 {
+		return 0
+	}
+	return d
+}
+
+// NormalizeRENAVAM returns the 11-digit form of a RENAVAM, left-padding
+// legacy 9-digit numbers with zeros.
+func NormalizeRENAVAM(renavam string) (string, error) {
+	renavam = RemoveNonDigits(renavam)
+	if len(renavam) != 9 && len(renavam) != 11 {
+		return "", ErrInvalidLength
+	}
+	return strings.Repeat("0", 11-len(renavam)) + renavam, nil
+}
+
+// RENAVAM validates a vehicle RENAVAM number, in the current 11-digit form
+// or the legacy 9-digit form.
+func RENAVAM(renavam string) bool {
+	renavam, err := NormalizeRENAVAM(renavam)
+	if err != nil {
+		return false
+	}
+
+	if IsRepetitive(renavam) {
+		return false
+	}
+
+	return int(renavam[10]-'0') == renavamDigit(renavam[:10])
+}
+
+// GenerateRENAVAM returns a random valid 11-digit RENAVAM, for tests.
+func GenerateRENAVAM() string {
+	for {
+		base := randomDigits(10)
+		renavam := base + string(byte('0'+renavamDigit(base)))
+		if !IsRepetitive(renavam) {
+			return renavam
+		}
+	}
+}
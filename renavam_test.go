@@ -0,0 +1,79 @@
+package validatebr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleNormalizeRENAVAM demonstrates how to convert a legacy RENAVAM to 11 digits.
+func ExampleNormalizeRENAVAM() {
+	renavam, err := validatebr.NormalizeRENAVAM("639884962")
+	fmt.Println(renavam, err)
+
+	// Output:
+	// 00639884962 <nil>
+}
+
+func TestRENAVAM(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "Valid RENAVAM",
+			input:    "14283256656",
+			expected: true,
+		},
+		{
+			name:     "Valid padded legacy RENAVAM",
+			input:    "00639884962",
+			expected: true,
+		},
+		{
+			name:     "Valid legacy 9-digit RENAVAM",
+			input:    "639884962",
+			expected: true,
+		},
+		{
+			name:     "Invalid check digit",
+			input:    "14283256657",
+			expected: false,
+		},
+		{
+			name:     "All repetitive digits",
+			input:    "00000000000",
+			expected: false,
+		},
+		{
+			name:     "Invalid length",
+			input:    "1428325665",
+			expected: false,
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.RENAVAM(tt.input)
+			if result != tt.expected {
+				t.Errorf("RENAVAM(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGenerateRENAVAM(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		renavam := validatebr.GenerateRENAVAM()
+		if !validatebr.RENAVAM(renavam) {
+			t.Fatalf("GenerateRENAVAM() = %q; not a valid RENAVAM", renavam)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+package validatebr
+
+import "slices"
+
+// CPFSuggestions returns the valid CPFs reachable from an invalid one by a
+// single digit substitution or a single adjacent transposition.
+func CPFSuggestions(cpf string) []string {
+	return suggestions(cpf, 11, CPF)
+}
+
+// CNPJSuggestions returns the valid CNPJs reachable from an invalid one by a
+// single digit substitution or a single adjacent transposition.
+func CNPJSuggestions(cnpj string) []string {
+	return suggestions(cnpj, 14, CNPJ)
+}
+
+func suggestions(s string, size int, valid func(string) bool) []string {
+	s = RemoveNonDigits(s)
+	if len(s) != size || valid(s) {
+		return nil
+	}
+
+	var ret []string
+	b := []byte(s)
+
+	for i := range b {
+		orig := b[i]
+		for c := byte('0'); c <= '9'; c++ {
+			if c == orig {
+				continue
+			}
+			b[i] = c
+			if valid(string(b)) {
+				ret = append(ret, string(b))
+			}
+		}
+		b[i] = orig
+	}
+
+	for i := 0; i < len(b)-1; i++ {
+		if b[i] == b[i+1] {
+			continue
+		}
+		b[i], b[i+1] = b[i+1], b[i]
+		if valid(string(b)) {
+			ret = append(ret, string(b))
+		}
+		b[i], b[i+1] = b[i+1], b[i]
+	}
+
+	slices.Sort(ret)
+	return slices.Compact(ret)
+}
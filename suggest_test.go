@@ -0,0 +1,98 @@
+package validatebr_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleCPFSuggestions demonstrates how to find the CPFs a user probably meant.
+func ExampleCPFSuggestions() {
+	fmt.Println(validatebr.CPFSuggestions("592.982.247-25"))
+
+	// Output:
+	// [52998224725 95298224725]
+}
+
+func TestCPFSuggestions(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "Wrong check digit",
+			input:    "529.982.247-24",
+			expected: []string{"52998224725"},
+		},
+		{
+			name:     "Transposed digits",
+			input:    "592.982.247-25",
+			expected: []string{"52998224725", "95298224725"},
+		},
+		{
+			name:     "Already valid",
+			input:    "529.982.247-25",
+			expected: nil,
+		},
+		{
+			name:     "Invalid length",
+			input:    "529.982.247-2",
+			expected: nil,
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.CPFSuggestions(tt.input)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("CPFSuggestions(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+			for _, s := range result {
+				if !validatebr.CPF(s) {
+					t.Errorf("CPFSuggestions(%q) returned invalid CPF %q", tt.input, s)
+				}
+			}
+		})
+	}
+}
+
+func TestCNPJSuggestions(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{
+			name:     "Wrong check digit",
+			input:    "12.345.678/0001-96",
+			expected: []string{"12345678000195"},
+		},
+		{
+			name:     "Already valid",
+			input:    "12.345.678/0001-95",
+			expected: nil,
+		},
+		{
+			name:     "Invalid length",
+			input:    "12.345.678/0001",
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.CNPJSuggestions(tt.input)
+			if !slices.Equal(result, tt.expected) {
+				t.Errorf("CNPJSuggestions(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
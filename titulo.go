@@ -0,0 +1,71 @@
+package validatebr
+
+import "errors"
+
+// tituloAbroad is the electoral UF code (ZZ) of voters living abroad.
+const tituloAbroad = 28
+
+var (
+	ErrInvalidTituloEleitor = errors.New("invalid titulo de eleitor")
+	ErrTituloEleitorAbroad  = errors.New("titulo de eleitor issued abroad")
+
+	tituloP1 = []int{2, 3, 4, 5, 6, 7, 8, 9}
+	tituloP2 = []int{7, 8, 9}
+
+	// UFs indexed by the electoral code in digits 9-10 of the título de
+	// eleitor, which differs from the IBGE code.
+	tituloUF = [tituloAbroad]UF{
+		0, SP, MG, RJ, RS, BA, PR, CE, PE, SC,
+		GO, MA, PB, PA, ES, PI, RN, AL, MT, MS,
+		DF, SE, AM, RO, AC, AP, RR, TO,
+	}
+)
+
+func tituloDigit(s string, table []int, uf int) int {
+	d := sum(s, table) % 11
+	if d == 10 {
+		return 0
+	}
+	// SP and MG use 1 instead of 0 when the remainder is zero.
+	if d == 0 && (uf == 1 || uf == 2) {
+		return 1
+	}
+	return d
+}
+
+// TituloEleitor validates a 12-digit título de eleitor (voter ID).
+func TituloEleitor(titulo string) bool {
+	titulo = RemoveNonDigits(titulo)
+	if len(titulo) != 12 {
+		return false
+	}
+
+	if IsRepetitive(titulo) {
+		return false
+	}
+
+	uf := int(titulo[8]-'0')*10 + int(titulo[9]-'0')
+	if uf < 1 || uf > tituloAbroad {
+		return false
+	}
+
+	d1 := tituloDigit(titulo[:8], tituloP1, uf)
+	d2 := tituloDigit(titulo[8:10]+string(byte('0'+d1)), tituloP2, uf)
+
+	return int(titulo[10]-'0') == d1 && int(titulo[11]-'0') == d2
+}
+
+// TituloEleitorUF returns the UF where a valid título de eleitor was
+// issued. Títulos of voters abroad (ZZ) are valid but have no UF and
+// return ErrTituloEleitorAbroad.
+func TituloEleitorUF(titulo string) (UF, error) {
+	if !TituloEleitor(titulo) {
+		return 0, ErrInvalidTituloEleitor
+	}
+	titulo = RemoveNonDigits(titulo)
+	uf := int(titulo[8]-'0')*10 + int(titulo[9]-'0')
+	if uf == tituloAbroad {
+		return 0, ErrTituloEleitorAbroad
+	}
+	return tituloUF[uf], nil
+}
@@ -0,0 +1,101 @@
+package validatebr_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleTituloEleitorUF demonstrates how to find where a título de eleitor was issued.
+func ExampleTituloEleitorUF() {
+	uf, err := validatebr.TituloEleitorUF("1023 8501 0671")
+	fmt.Println(uf, uf.Name(), err)
+
+	// Output:
+	// PR Paraná <nil>
+}
+
+func TestTituloEleitor(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+		uf       validatebr.UF
+		err      error
+	}{
+		{
+			name:     "Valid título from PR",
+			input:    "102385010671",
+			expected: true,
+			uf:       validatebr.PR,
+		},
+		{
+			name:     "Valid título with mask",
+			input:    "0043 5687 0906",
+			expected: true,
+			uf:       validatebr.SC,
+		},
+		{
+			name:     "Valid título from MG with remainder zero",
+			input:    "100000010213",
+			expected: true,
+			uf:       validatebr.MG,
+		},
+		{
+			name:     "Remainder zero outside SP and MG",
+			input:    "100000010302",
+			expected: true,
+			uf:       validatebr.RJ,
+		},
+		{
+			name:     "Valid título from a voter abroad",
+			input:    "000000012895",
+			expected: true,
+			err:      validatebr.ErrTituloEleitorAbroad,
+		},
+		{
+			name:     "Invalid check digit",
+			input:    "102385010672",
+			expected: false,
+			err:      validatebr.ErrInvalidTituloEleitor,
+		},
+		{
+			name:     "Invalid UF code",
+			input:    "123456782991",
+			expected: false,
+			err:      validatebr.ErrInvalidTituloEleitor,
+		},
+		{
+			name:     "All repetitive digits",
+			input:    "111111111111",
+			expected: false,
+			err:      validatebr.ErrInvalidTituloEleitor,
+		},
+		{
+			name:     "Invalid length",
+			input:    "10238501067",
+			expected: false,
+			err:      validatebr.ErrInvalidTituloEleitor,
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: false,
+			err:      validatebr.ErrInvalidTituloEleitor,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.TituloEleitor(tt.input)
+			if result != tt.expected {
+				t.Errorf("TituloEleitor(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+			uf, err := validatebr.TituloEleitorUF(tt.input)
+			if uf != tt.uf || err != tt.err {
+				t.Errorf("TituloEleitorUF(%q) = %v, %v; want %v, %v", tt.input, uf, err, tt.uf, tt.err)
+			}
+		})
+	}
+}
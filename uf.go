@@ -0,0 +1,164 @@
+package validatebr
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// UF is a Brazilian federative unit, identified by its IBGE code.
+type UF int
+
+const (
+	RO UF = 11
+	AC UF = 12
+	AM UF = 13
+	RR UF = 14
+	PA UF = 15
+	AP UF = 16
+	TO UF = 17
+	MA UF = 21
+	PI UF = 22
+	CE UF = 23
+	RN UF = 24
+	PB UF = 25
+	PE UF = 26
+	AL UF = 27
+	SE UF = 28
+	BA UF = 29
+	MG UF = 31
+	ES UF = 32
+	RJ UF = 33
+	SP UF = 35
+	PR UF = 41
+	SC UF = 42
+	RS UF = 43
+	MS UF = 50
+	MT UF = 51
+	GO UF = 52
+	DF UF = 53
+)
+
+type Region int
+
+const (
+	RegionNorte Region = iota + 1
+	RegionNordeste
+	RegionSudeste
+	RegionSul
+	RegionCentroOeste
+)
+
+var (
+	ErrInvalidUF = errors.New("invalid uf")
+
+	ufs = map[UF]struct {
+		abbr    string
+		name    string
+		capital string
+		region  Region
+	}{
+		RO: {"RO", "Rondônia", "Porto Velho", RegionNorte},
+		AC: {"AC", "Acre", "Rio Branco", RegionNorte},
+		AM: {"AM", "Amazonas", "Manaus", RegionNorte},
+		RR: {"RR", "Roraima", "Boa Vista", RegionNorte},
+		PA: {"PA", "Pará", "Belém", RegionNorte},
+		AP: {"AP", "Amapá", "Macapá", RegionNorte},
+		TO: {"TO", "Tocantins", "Palmas", RegionNorte},
+		MA: {"MA", "Maranhão", "São Luís", RegionNordeste},
+		PI: {"PI", "Piauí", "Teresina", RegionNordeste},
+		CE: {"CE", "Ceará", "Fortaleza", RegionNordeste},
+		RN: {"RN", "Rio Grande do Norte", "Natal", RegionNordeste},
+		PB: {"PB", "Paraíba", "João Pessoa", RegionNordeste},
+		PE: {"PE", "Pernambuco", "Recife", RegionNordeste},
+		AL: {"AL", "Alagoas", "Maceió", RegionNordeste},
+		SE: {"SE", "Sergipe", "Aracaju", RegionNordeste},
+		BA: {"BA", "Bahia", "Salvador", RegionNordeste},
+		MG: {"MG", "Minas Gerais", "Belo Horizonte", RegionSudeste},
+		ES: {"ES", "Espírito Santo", "Vitória", RegionSudeste},
+		RJ: {"RJ", "Rio de Janeiro", "Rio de Janeiro", RegionSudeste},
+		SP: {"SP", "São Paulo", "São Paulo", RegionSudeste},
+		PR: {"PR", "Paraná", "Curitiba", RegionSul},
+		SC: {"SC", "Santa Catarina", "Florianópolis", RegionSul},
+		RS: {"RS", "Rio Grande do Sul", "Porto Alegre", RegionSul},
+		MS: {"MS", "Mato Grosso do Sul", "Campo Grande", RegionCentroOeste},
+		MT: {"MT", "Mato Grosso", "Cuiabá", RegionCentroOeste},
+		GO: {"GO", "Goiás", "Goiânia", RegionCentroOeste},
+		DF: {"DF", "Distrito Federal", "Brasília", RegionCentroOeste},
+	}
+)
+
+func (r Region) String() string {
+	switch r {
+	case RegionNorte:
+		return "Norte"
+	case RegionNordeste:
+		return "Nordeste"
+	case RegionSudeste:
+		return "Sudeste"
+	case RegionSul:
+		return "Sul"
+	case RegionCentroOeste:
+		return "Centro-Oeste"
+	}
+	return ""
+}
+
+// ParseUF parses a UF from its abbreviation, its name (ignoring case and
+// accents) or its IBGE code.
+func ParseUF(s string) (UF, error) {
+	s = strings.TrimSpace(s)
+	if code, err := strconv.Atoi(s); err == nil {
+		if UF(code).Valid() {
+			return UF(code), nil
+		}
+		return 0, ErrInvalidUF
+	}
+
+	folded := foldAccents(s)
+	for uf, v := range ufs {
+		if strings.EqualFold(v.abbr, s) || foldAccents(v.name) == folded {
+			return uf, nil
+		}
+	}
+
+	return 0, ErrInvalidUF
+}
+
+// UFs returns all 27 federative units ordered by IBGE code.
+func UFs() []UF {
+	return []UF{
+		RO, AC, AM, RR, PA, AP, TO,
+		MA, PI, CE, RN, PB, PE, AL, SE, BA,
+		MG, ES, RJ, SP,
+		PR, SC, RS,
+		MS, MT, GO, DF,
+	}
+}
+
+func (u UF) Valid() bool {
+	_, ok := ufs[u]
+	return ok
+}
+
+// String returns the two-letter abbreviation of the UF.
+func (u UF) String() string {
+	return ufs[u].abbr
+}
+
+// Code returns the IBGE code of the UF.
+func (u UF) Code() int {
+	return int(u)
+}
+
+func (u UF) Name() string {
+	return ufs[u].name
+}
+
+func (u UF) Capital() string {
+	return ufs[u].capital
+}
+
+func (u UF) Region() Region {
+	return ufs[u].region
+}
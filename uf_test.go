@@ -0,0 +1,105 @@
+package validatebr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleParseUF demonstrates how to parse a UF from its name, abbreviation or IBGE code.
+func ExampleParseUF() {
+	for _, s := range []string{"sp", "Sao Paulo", "35"} {
+		uf, err := validatebr.ParseUF(s)
+		if err != nil {
+			fmt.Println(err)
+			continue
+		}
+		fmt.Println(uf, uf.Code(), uf.Name(), uf.Capital(), uf.Region())
+	}
+
+	// Output:
+	// SP 35 São Paulo São Paulo Sudeste
+	// SP 35 São Paulo São Paulo Sudeste
+	// SP 35 São Paulo São Paulo Sudeste
+}
+
+func TestParseUF(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected validatebr.UF
+		err      error
+	}{
+		{input: "DF", expected: validatebr.DF},
+		{input: "rs", expected: validatebr.RS},
+		{input: "Espírito Santo", expected: validatebr.ES},
+		{input: "PARA", expected: validatebr.PA},
+		{input: "rio grande do norte", expected: validatebr.RN},
+		{input: "11", expected: validatebr.RO},
+		{input: " 53 ", expected: validatebr.DF},
+		{input: "34", err: validatebr.ErrInvalidUF},
+		{input: "XX", err: validatebr.ErrInvalidUF},
+		{input: "", err: validatebr.ErrInvalidUF},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			uf, err := validatebr.ParseUF(tt.input)
+			if uf != tt.expected || !errors.Is(err, tt.err) {
+				t.Errorf("ParseUF(%q) = %v, %v; want %v, %v", tt.input, uf, err, tt.expected, tt.err)
+			}
+		})
+	}
+}
+
+func TestUFs(t *testing.T) {
+	ufs := validatebr.UFs()
+	if len(ufs) != 27 {
+		t.Fatalf("len(UFs()) = %d; want 27", len(ufs))
+	}
+
+	for _, uf := range ufs {
+		if !uf.Valid() || uf.Name() == "" || uf.Capital() == "" || uf.Region().String() == "" {
+			t.Errorf("UF %d has missing data", uf.Code())
+		}
+		parsed, err := validatebr.ParseUF(uf.String())
+		if parsed != uf || err != nil {
+			t.Errorf("ParseUF(%q) = %v, %v; want %v", uf.String(), parsed, err, uf)
+		}
+		parsed, err = validatebr.ParseUF(uf.Name())
+		if parsed != uf || err != nil {
+			t.Errorf("ParseUF(%q) = %v, %v; want %v", uf.Name(), parsed, err, uf)
+		}
+	}
+
+	if validatebr.UF(34).Valid() {
+		t.Error("UF(34).Valid() = true; want false")
+	}
+}
+
+func TestDDDUF(t *testing.T) {
+	for ddd := 0; ddd < 100; ddd++ {
+		_, ok := validatebr.DDDUF(ddd)
+		if ok != validatebr.IsValidDDD(ddd) {
+			t.Errorf("DDDUF(%d) found = %v; IsValidDDD = %v", ddd, ok, validatebr.IsValidDDD(ddd))
+		}
+	}
+
+	tests := []struct {
+		ddd      int
+		expected validatebr.UF
+	}{
+		{ddd: 11, expected: validatebr.SP},
+		{ddd: 21, expected: validatebr.RJ},
+		{ddd: 61, expected: validatebr.DF},
+		{ddd: 64, expected: validatebr.GO},
+		{ddd: 99, expected: validatebr.MA},
+	}
+
+	for _, tt := range tests {
+		if uf, _ := validatebr.DDDUF(tt.ddd); uf != tt.expected {
+			t.Errorf("DDDUF(%d) = %v; want %v", tt.ddd, uf, tt.expected)
+		}
+	}
+}
@@ -2,6 +2,7 @@ package validatebr
 
 import (
 	"errors"
+	"math/rand/v2"
 	"regexp"
 	"slices"
 	"strconv"
@@ -24,6 +25,7 @@ var (
 
 	ErrInvalidPixType   = errors.New("invalid pix type")
 	ErrInvalidCharacter = errors.New("invalid character")
+	ErrInvalidLength    = errors.New("invalid length")
 )
 
 func IsEmailValid(e string) bool {
@@ -77,6 +79,29 @@ func IsRepetitive(s string) bool {
 	return true
 }
 
+// applyMask replaces each '#' in mask with the next character of s.
+func applyMask(s, mask string) string {
+	var b strings.Builder
+	i := 0
+	for _, c := range mask {
+		if c == '#' {
+			b.WriteByte(s[i])
+			i++
+			continue
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+func randomDigits(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte('0' + rand.IntN(10))
+	}
+	return string(b)
+}
+
 func sum(s string, table []int) int {
 	r := 0
 
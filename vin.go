@@ -0,0 +1,139 @@
+package validatebr
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	vinYearCodes = "ABCDEFGHJKLMNPRSTVWXY123456789"
+
+	// transliteration of A-Z for the check digit; I, O and Q are unused.
+	vinLetterValues = "12345678012345070923456789"
+)
+
+var (
+	vinP = []int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+	// World manufacturer identifiers assigned to vehicles built in Brazil.
+	vinManufacturers = map[string]string{
+		"935": "Citroën",
+		"936": "Peugeot",
+		"93H": "Honda",
+		"93Y": "Renault",
+		"94D": "Nissan",
+		"9BD": "Fiat",
+		"9BF": "Ford",
+		"9BG": "Chevrolet",
+		"9BH": "Hyundai",
+		"9BM": "Mercedes-Benz",
+		"9BR": "Toyota",
+		"9BW": "Volkswagen",
+		"9C2": "Honda Motos",
+		"9C6": "Yamaha",
+	}
+
+	ErrInvalidVIN = errors.New("invalid vin")
+)
+
+type VINInfo struct {
+	WMI          string // world manufacturer identifier, positions 1-3
+	VDS          string // vehicle descriptor section, positions 4-9
+	VIS          string // vehicle identifier section, positions 10-17
+	Manufacturer string // empty when the WMI is unknown
+}
+
+func vinValue(c byte) int {
+	if c >= '0' && c <= '9' {
+		return int(c - '0')
+	}
+	return int(vinLetterValues[c-'A'] - '0')
+}
+
+// VIN validates a 17-character chassi number using the Brazilian rules:
+// no I, O or Q, the last four characters numeric and no character
+// repeated more than six times in a row.
+func VIN(vin string) bool {
+	vin = strings.ToUpper(RemoveNonAlphaNum(vin))
+	if len(vin) != 17 {
+		return false
+	}
+
+	repeat := 1
+	for i := 0; i < len(vin); i++ {
+		c := vin[i]
+		if c < '0' || (c > '9' && c < 'A') || c > 'Z' ||
+			c == 'I' || c == 'O' || c == 'Q' {
+			return false
+		}
+		if i >= 13 && (c < '0' || c > '9') {
+			return false
+		}
+		if i > 0 && vin[i-1] == c {
+			repeat++
+			if repeat > 6 {
+				return false
+			}
+			continue
+		}
+		repeat = 1
+	}
+
+	return true
+}
+
+// VINCheckDigit validates the check digit at position 9. Brazilian
+// manufacturers are not required to fill it, so VIN does not check it.
+func VINCheckDigit(vin string) bool {
+	vin = strings.ToUpper(RemoveNonAlphaNum(vin))
+	if !VIN(vin) {
+		return false
+	}
+
+	s := 0
+	for i, v := range vinP {
+		s += vinValue(vin[i]) * v
+	}
+
+	d := byte('0' + s%11)
+	if s%11 == 10 {
+		d = 'X'
+	}
+
+	return vin[8] == d
+}
+
+func ParseVIN(vin string) (VINInfo, error) {
+	vin = strings.ToUpper(RemoveNonAlphaNum(vin))
+	if !VIN(vin) {
+		return VINInfo{}, ErrInvalidVIN
+	}
+
+	return VINInfo{
+		WMI:          vin[:3],
+		VDS:          vin[3:9],
+		VIS:          vin[9:],
+		Manufacturer: vinManufacturers[vin[:3]],
+	}, nil
+}
+
+// ModelYear decodes the year code at position 10. The code repeats every
+// 30 years, so the most recent year not after ref+1 is returned, or 0 when
+// the code is not a valid year code.
+func (v VINInfo) ModelYear(ref int) int {
+	if len(v.VIS) == 0 {
+		return 0
+	}
+
+	i := strings.IndexByte(vinYearCodes, v.VIS[0])
+	if i < 0 {
+		return 0
+	}
+
+	year := 1980 + i
+	for year+30 <= ref+1 {
+		year += 30
+	}
+
+	return year
+}
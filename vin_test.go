@@ -0,0 +1,144 @@
+package validatebr_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/crgimenes/validatebr"
+)
+
+// ExampleParseVIN demonstrates how to decode the manufacturer and model year of a chassi number.
+func ExampleParseVIN() {
+	info, err := validatebr.ParseVIN("9BD15822AB6112345")
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(info.WMI, info.Manufacturer, info.ModelYear(2025))
+
+	// Output:
+	// 9BD Fiat 2011
+}
+
+func TestVIN(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "Valid Brazilian VIN",
+			input:    "9BWZZZ377VT004251",
+			expected: true,
+		},
+		{
+			name:     "Valid VIN in lowercase",
+			input:    "9bd15822ab6112345",
+			expected: true,
+		},
+		{
+			name:     "Letter I is not allowed",
+			input:    "9BWZZZ377IT004251",
+			expected: false,
+		},
+		{
+			name:     "Letter O is not allowed",
+			input:    "9BWZZZ377OT004251",
+			expected: false,
+		},
+		{
+			name:     "Letter Q is not allowed",
+			input:    "9BWZZZ377QT004251",
+			expected: false,
+		},
+		{
+			name:     "Last four characters must be numeric",
+			input:    "9BWZZZ377VT00425A",
+			expected: false,
+		},
+		{
+			name:     "Character repeated more than six times",
+			input:    "9BWZZZZZZZT004251",
+			expected: false,
+		},
+		{
+			name:     "Invalid length",
+			input:    "9BWZZZ377VT00425",
+			expected: false,
+		},
+		{
+			name:     "Empty string",
+			input:    "",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.VIN(tt.input)
+			if result != tt.expected {
+				t.Errorf("VIN(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestVINCheckDigit(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{
+			name:     "Valid check digit X",
+			input:    "1M8GDM9AXKP042788",
+			expected: true,
+		},
+		{
+			name:     "Valid numeric check digit",
+			input:    "JHMCM56557C404453",
+			expected: true,
+		},
+		{
+			name:     "Invalid check digit",
+			input:    "JHMCM56567C404453",
+			expected: false,
+		},
+		{
+			name:     "Invalid VIN",
+			input:    "JHMCM56557C40445",
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validatebr.VINCheckDigit(tt.input)
+			if result != tt.expected {
+				t.Errorf("VINCheckDigit(%q) = %v; want %v", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestParseVIN(t *testing.T) {
+	info, err := validatebr.ParseVIN("9BWZZZ377VT004251")
+	if err != nil {
+		t.Fatalf("ParseVIN() error = %v", err)
+	}
+	if info.WMI != "9BW" || info.VDS != "ZZZ377" || info.VIS != "VT004251" || info.Manufacturer != "Volkswagen" {
+		t.Errorf("ParseVIN() = %+v", info)
+	}
+	if y := info.ModelYear(2020); y != 1997 {
+		t.Errorf("ModelYear(2020) = %d; want 1997", y)
+	}
+	if y := info.ModelYear(2026); y != 2027 {
+		t.Errorf("ModelYear(2026) = %d; want 2027", y)
+	}
+
+	_, err = validatebr.ParseVIN("9BWZZZ377VT00425")
+	if !errors.Is(err, validatebr.ErrInvalidVIN) {
+		t.Errorf("ParseVIN() error = %v; want %v", err, validatebr.ErrInvalidVIN)
+	}
+}